@@ -3,11 +3,15 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"maps"
+	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/reporter"
 	"github.com/jackchuka/mdschema/internal/rules"
+	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/spf13/cobra"
 )
 
@@ -16,25 +20,89 @@ var ErrViolationsFound = errors.New("validation violations found")
 
 // NewCheckCmd creates the check command
 func NewCheckCmd() *cobra.Command {
-	return &cobra.Command{
+	var disabledRules []string
+	var ruleSeverities []string
+
+	cmd := &cobra.Command{
 		Use:   "check [globs...]",
 		Short: "Validate Markdown files against schema",
-		Long:  `Check validates Markdown files matching the given glob patterns against the configured schema.`,
-		Args:  cobra.MinimumNArgs(1),
+		Long: `Check validates Markdown files matching the given glob patterns against the configured schema.
+
+Rules, or individual checks by code, can be turned off or have their severity
+overridden, taking precedence over the schema's rules map:
+
+  mdschema check --disable-rule paragraph --rule-severity link=warning --rule-severity MDS102=info "docs/**/*.md"
+
+Run "mdschema explain" to list check codes.`,
+		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg := ConfigFromContext(cmd.Context())
-			return runCheck(cfg, args)
+			overrides, err := parseRuleOverrides(disabledRules, ruleSeverities)
+			if err != nil {
+				return err
+			}
+			return runCheck(cfg, args, overrides)
 		},
 	}
+
+	cmd.Flags().StringSliceVar(&disabledRules, "disable-rule", nil, "Disable a rule by name or a check by code (repeatable)")
+	cmd.Flags().StringSliceVar(&ruleSeverities, "rule-severity", nil, "Override a rule's or check's severity as name=level, e.g. link=warning or MDS102=info (repeatable)")
+
+	return cmd
 }
 
-func runCheck(cfg *Config, globs []string) error {
+// parseRuleOverrides converts --disable-rule and --rule-severity flag values
+// into rule levels keyed by rule name or check code
+func parseRuleOverrides(disabled, severities []string) (map[string]schema.RuleLevel, error) {
+	overrides := make(map[string]schema.RuleLevel)
+	for _, name := range disabled {
+		if !rules.IsRuleKey(name) {
+			return nil, fmt.Errorf("--disable-rule: %w", unknownRuleError(name))
+		}
+		overrides[name] = schema.RuleLevelOff
+	}
+	for _, entry := range severities {
+		name, level, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("--rule-severity: expected name=level, got %q", entry)
+		}
+		if !rules.IsRuleKey(name) {
+			return nil, fmt.Errorf("--rule-severity: %w", unknownRuleError(name))
+		}
+		if !schema.RuleLevel(level).Valid() {
+			return nil, fmt.Errorf("--rule-severity: invalid level %q for rule %q (use off, info, warning, or error)", level, name)
+		}
+		overrides[name] = schema.RuleLevel(level)
+	}
+	return overrides, nil
+}
+
+// unknownRuleError describes a rule name or code that does not exist
+func unknownRuleError(name string) error {
+	return fmt.Errorf("unknown rule %q (rules: %s; or a check code, see \"mdschema explain\")", name, strings.Join(rules.RuleNames(), ", "))
+}
+
+func runCheck(cfg *Config, globs []string, overrides map[string]schema.RuleLevel) error {
 	// Load schema
 	s, schemaPath, err := loadSchema(cfg)
 	if err != nil {
 		return fmt.Errorf("loading schema: %w", err)
 	}
 
+	// Warn about rule names and codes the validator does not know
+	for name := range s.Rules {
+		if !rules.IsRuleKey(name) {
+			fmt.Fprintf(os.Stderr, "warning: %s: unknown rule %q in rules (ignored)\n", schemaPath, name)
+		}
+	}
+	// Command-line overrides take precedence over the schema's rules map
+	if len(overrides) > 0 {
+		if s.Rules == nil {
+			s.Rules = make(map[string]schema.RuleLevel)
+		}
+		maps.Copy(s.Rules, overrides)
+	}
+
 	// Find matching files
 	files, err := findFiles(globs)
 	if err != nil {
@@ -0,0 +1,66 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/jackchuka/mdschema/internal/rules"
+	"github.com/spf13/cobra"
+)
+
+// NewExplainCmd creates the explain command
+func NewExplainCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "explain [code]",
+		Short: "Explain a check code such as MDS102",
+		Long: `Explain prints the rationale, an example, and how to fix violations of a check,
+identified by its code (e.g. MDS102) or name (e.g. out-of-order).
+
+Without arguments, it lists every check code.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return listCodes(os.Stdout)
+			}
+			info, ok := rules.LookupCode(args[0])
+			if !ok {
+				return fmt.Errorf("unknown check code %q (run \"mdschema explain\" to list codes)", args[0])
+			}
+			explainCode(os.Stdout, info)
+			return nil
+		},
+	}
+}
+
+// listCodes writes a table of all check codes
+func listCodes(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, info := range rules.Codes() {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", info.Code, info.Name, info.Rule, info.Summary)
+	}
+	return tw.Flush()
+}
+
+// explainCode writes the documentation for a single check code
+func explainCode(w io.Writer, info rules.CodeInfo) {
+	_, _ = fmt.Fprintf(w, "%s %s (rule: %s)\n\n", info.Code, info.Name, info.Rule)
+	_, _ = fmt.Fprintf(w, "%s\n\n", info.Summary)
+	_, _ = fmt.Fprintf(w, "Why:\n  %s\n\n", info.Rationale)
+	_, _ = fmt.Fprintf(w, "Example:\n%s\n\n", indent(info.Example, "  "))
+	_, _ = fmt.Fprintf(w, "Fix:\n  %s\n\n", info.Fix)
+	_, _ = fmt.Fprintf(w, "Disable with \"rules: {%s: off}\" in the schema or --disable-rule %s.\n", info.Code, info.Code)
+}
+
+// indent prefixes every non-empty line of s
+func indent(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
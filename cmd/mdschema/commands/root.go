@@ -54,6 +54,8 @@ using declarative schemas to maintain consistent documentation across projects.`
 	cmd.AddCommand(NewDeriveCmd())
 	cmd.AddCommand(NewVersionCmd())
 	cmd.AddCommand(NewSchemaCmd())
+	cmd.AddCommand(NewExplainCmd())
+	cmd.AddCommand(NewExtractCmd())
 
 	return cmd
 }
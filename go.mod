@@ -3,6 +3,7 @@ module github.com/jackchuka/mdschema
 go 1.26.5
 
 require (
+	github.com/BurntSushi/toml v1.6.0
 	github.com/expr-lang/expr v1.17.8
 	github.com/fatih/color v1.19.0
 	github.com/invopop/jsonschema v0.14.0
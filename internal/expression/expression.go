@@ -0,0 +1,265 @@
+// Package expression compiles and evaluates the expr-lang expressions used in
+// schemas: heading expressions and frontmatter assertions. Compiled programs
+// are cached by expression, so each is compiled once per run.
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/expr-lang/expr"
+	exprparser "github.com/expr-lang/expr/parser"
+	"github.com/expr-lang/expr/vm"
+	"github.com/jackchuka/mdschema/internal/parser"
+)
+
+// Heading holds the variables of a heading expression
+type Heading struct {
+	Filename string         // Document file name without extension
+	Dirname  string         // Name of the directory containing the document
+	Path     string         // Document path relative to the schema directory, with forward slashes
+	Text     string         // Heading text
+	Level    int            // Heading level 1-6
+	Parent   string         // Parent heading text, "" at the top level
+	Index    int            // Position among sibling sections, from 0
+	FM       map[string]any // Frontmatter, empty when the document has none
+}
+
+// env returns the expression environment of a heading
+func (h Heading) env() map[string]any {
+	env := functions()
+	env["filename"] = h.Filename
+	env["dirname"] = h.Dirname
+	env["path"] = h.Path
+	env["heading"] = h.Text
+	env["level"] = h.Level
+	env["parent"] = h.Parent
+	env["index"] = h.Index
+	env["fm"] = normalize(h.FM)
+	return env
+}
+
+var (
+	mu       sync.Mutex
+	programs = make(map[string]*vm.Program) // Compiled programs by kind and expression
+)
+
+// CheckHeading reports whether a heading expression compiles
+func CheckHeading(expression string) error {
+	_, err := compileHeading(expression)
+	return err
+}
+
+// MatchHeading evaluates a heading expression
+func MatchHeading(expression string, h Heading) (bool, error) {
+	program, err := compileHeading(expression)
+	if err != nil {
+		return false, err
+	}
+	return run(program, h.env())
+}
+
+func compileHeading(expression string) (*vm.Program, error) {
+	return compile("heading\x00"+expression, func() (*vm.Program, error) {
+		return expr.Compile(expression, expr.Env(Heading{}.env()), expr.AsBool())
+	})
+}
+
+// CheckAssertion reports whether a frontmatter assertion parses. Names are
+// not checked, since field variables are only known per document.
+func CheckAssertion(expression string) error {
+	if _, err := exprparser.Parse(expression); err != nil {
+		first, _, _ := strings.Cut(err.Error(), "\n")
+		return errors.New(first)
+	}
+	return nil
+}
+
+// EvalAssertion evaluates a frontmatter assertion over frontmatter data.
+// Top-level fields are variables, with missing fields evaluating to nil, and
+// fm holds the whole map for keys that are not valid identifiers (e.g.
+// fm["x-id"]) or membership tests ("replaced_by" in fm). A field named like
+// a function (e.g. title or date) hides the function.
+func EvalAssertion(expression string, data map[string]any) (bool, error) {
+	data = normalize(data)
+	env := functions()
+	maps.Copy(env, data)
+	env["fm"] = data
+
+	// Field types are part of the compiled program, so documents share a
+	// program when their frontmatter has the same shape
+	shape := make([]string, 0, len(data))
+	for name, value := range data {
+		shape = append(shape, fmt.Sprintf("%s:%T", name, value))
+	}
+	slices.Sort(shape)
+
+	key := "assertion\x00" + strings.Join(shape, ",") + "\x00" + expression
+	program, err := compile(key, func() (*vm.Program, error) {
+		return expr.Compile(expression, expr.Env(env), expr.AllowUndefinedVariables(), expr.AsBool())
+	})
+	if err != nil {
+		return false, err
+	}
+	return run(program, env)
+}
+
+// compile returns the cached program for key, compiling it on first use.
+// Errors keep only their first line; expr appends a source excerpt.
+func compile(key string, build func() (*vm.Program, error)) (*vm.Program, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	if program, ok := programs[key]; ok {
+		return program, nil
+	}
+	program, err := build()
+	if err != nil {
+		first, _, _ := strings.Cut(err.Error(), "\n")
+		return nil, errors.New(first)
+	}
+	programs[key] = program
+	return program, nil
+}
+
+func run(program *vm.Program, env map[string]any) (bool, error) {
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, err
+	}
+	matched, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %T, want bool", result)
+	}
+	return matched, nil
+}
+
+// functions returns the helper functions available to every expression
+func functions() map[string]any {
+	return map[string]any{
+		"slug":        parser.GenerateSlug,
+		"kebab":       toKebabCase,
+		"title":       titleCase,
+		"words":       strings.Fields,
+		"semver":      isSemver,
+		"lower":       strings.ToLower,
+		"upper":       strings.ToUpper,
+		"trim":        strings.TrimSpace,
+		"strContains": strings.Contains,
+		"hasPrefix":   strings.HasPrefix,
+		"hasSuffix":   strings.HasSuffix,
+		"replace":     strings.ReplaceAll,
+		"trimPrefix":  trimPrefixRegex,
+		"trimSuffix":  trimSuffixRegex,
+		"match":       matchRegex,
+	}
+}
+
+// normalize converts the map[any]any values goldmark-meta produces for
+// nested maps to map[string]any, so expressions index every level alike. A
+// nil map becomes empty, so fm.title is nil rather than an error.
+func normalize(data map[string]any) map[string]any {
+	if data == nil {
+		return map[string]any{}
+	}
+	return stringKeyed(data).(map[string]any)
+}
+
+func stringKeyed(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, elem := range val {
+			out[k] = stringKeyed(elem)
+		}
+		return out
+	case map[any]any:
+		out := make(map[string]any, len(val))
+		for k, elem := range val {
+			out[fmt.Sprint(k)] = stringKeyed(elem)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, elem := range val {
+			out[i] = stringKeyed(elem)
+		}
+		return out
+	}
+	return v
+}
+
+// titleCase upper-cases the first letter of every space-separated word,
+// leaving the rest of each word (e.g. acronyms) as is
+func titleCase(s string) string {
+	words := strings.Split(s, " ")
+	for i, w := range words {
+		if r, size := utf8.DecodeRuneInString(w); size > 0 {
+			words[i] = string(unicode.ToUpper(r)) + w[size:]
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// semverRegex matches a semantic version with an optional v prefix
+var semverRegex = regexp.MustCompile(`^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// isSemver reports whether s is a semantic version, e.g. v1.2.3 or 2.0.0-rc.1
+func isSemver(s string) bool {
+	return semverRegex.MatchString(s)
+}
+
+// trimPrefixRegex removes a regex pattern from the start of a string
+func trimPrefixRegex(s, pattern string) string {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return s
+	}
+	loc := re.FindStringIndex(s)
+	if loc != nil && loc[0] == 0 {
+		return s[loc[1]:]
+	}
+	return s
+}
+
+// trimSuffixRegex removes a regex pattern from the end of a string
+func trimSuffixRegex(s, pattern string) string {
+	re, err := regexp.Compile(pattern + "$")
+	if err != nil {
+		return s
+	}
+	return re.ReplaceAllString(s, "")
+}
+
+// matchRegex checks if a string matches a regex pattern
+func matchRegex(s, pattern string) bool {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false
+	}
+	return re.MatchString(s)
+}
+
+// toKebabCase converts PascalCase/camelCase to kebab-case
+// Examples: "CreateUnit" -> "create-unit", "XMLParser" -> "xml-parser"
+func toKebabCase(s string) string {
+	var result strings.Builder
+	for i, r := range s {
+		if i > 0 && r >= 'A' && r <= 'Z' {
+			// Check if previous char was lowercase or next char is lowercase (for acronyms)
+			prevLower := i > 0 && s[i-1] >= 'a' && s[i-1] <= 'z'
+			nextLower := i+1 < len(s) && s[i+1] >= 'a' && s[i+1] <= 'z'
+			if prevLower || nextLower {
+				result.WriteRune('-')
+			}
+		}
+		result.WriteRune(r)
+	}
+	return strings.ToLower(result.String())
+}
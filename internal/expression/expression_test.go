@@ -0,0 +1,97 @@
+package expression
+
+import (
+	"testing"
+)
+
+func TestMatchHeading(t *testing.T) {
+	h := Heading{
+		Filename: "install",
+		Dirname:  "guides",
+		Path:     "docs/guides/install.md",
+		Text:     "Release v1.2.0",
+		Level:    2,
+		Parent:   "Changelog",
+		Index:    1,
+		FM:       map[string]any{"title": "Changelog", "meta": map[any]any{"owner": "docs"}},
+	}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`dirname == "guides" && hasPrefix(path, "docs/")`, true},
+		{`parent == fm.title && index == 1 && level == 2`, true},
+		{`fm.meta.owner == "docs"`, true},
+		{`semver(words(heading)[1])`, true},
+		{`semver("1.2")`, false},
+		{`title("getting started with API") == "Getting Started With API"`, true},
+		{`len(words(heading)) <= 1`, false},
+		{`fm.missing == nil`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			got, err := MatchHeading(tt.expr, h)
+			if err != nil {
+				t.Fatalf("MatchHeading() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("MatchHeading() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckHeading(t *testing.T) {
+	for _, expr := range []string{`heading ==`, `unknown(heading)`, `level + "x"`, `heading`} {
+		if err := CheckHeading(expr); err == nil {
+			t.Errorf("CheckHeading(%q) should fail", expr)
+		}
+	}
+	if err := CheckHeading(`slug(filename) == slug(heading)`); err != nil {
+		t.Errorf("CheckHeading() error: %v", err)
+	}
+}
+
+func TestEvalAssertion(t *testing.T) {
+	data := map[string]any{"title": "Guide", "date": "2024-03-01", "updated": "2024-02-01"}
+
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{`title == "Guide"`, true}, // fields hide functions of the same name
+		{`updated >= date`, false},
+		{`reviewers == nil && !("reviewers" in fm)`, true},
+	}
+
+	for _, tt := range tests {
+		got, err := EvalAssertion(tt.expr, data)
+		if err != nil {
+			t.Fatalf("EvalAssertion(%q) error: %v", tt.expr, err)
+		}
+		if got != tt.want {
+			t.Errorf("EvalAssertion(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+
+	if _, err := EvalAssertion(`updated >=`, data); err == nil {
+		t.Error("EvalAssertion() should fail for a syntax error")
+	}
+}
+
+func TestProgramsAreCached(t *testing.T) {
+	const expr = `level == 3 && heading != ""`
+	for range 3 {
+		if _, err := MatchHeading(expr, Heading{Text: "A", Level: 3}); err != nil {
+			t.Fatalf("MatchHeading() error: %v", err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if _, ok := programs["heading\x00"+expr]; !ok {
+		t.Error("compiled program was not cached")
+	}
+}
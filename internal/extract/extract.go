@@ -0,0 +1,162 @@
+package extract
+
+import (
+	"cmp"
+	"fmt"
+	"go/format"
+	"io"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
+	"github.com/jackchuka/mdschema/internal/vast"
+)
+
+// Selector chooses which code blocks to extract
+type Selector struct {
+	// Section selects blocks in sections bound to a schema element, including
+	// their subsections. It matches the element's heading as written in the
+	// schema (e.g. "## Usage") or the section's heading text. Empty selects
+	// every section.
+	Section string
+
+	// Lang selects blocks by language, resolving the schema's code_blocks
+	// aliases. Empty selects every language.
+	Lang string
+}
+
+// Snippet is a code block extracted from a document
+type Snippet struct {
+	Path    string // Document path
+	Line    int    // Document line of the first line of the block body
+	Lang    string
+	Content string
+	File    string // Output file name, see FileName
+}
+
+// Source returns the snippet's location in the document as path:line
+func (s Snippet) Source() string {
+	return fmt.Sprintf("%s:%d", s.Path, s.Line)
+}
+
+// Select returns the code blocks in doc matching sel, in document order.
+// path is the document path recorded on each snippet.
+func Select(doc *parser.Document, s *schema.Schema, sel Selector, path string) []Snippet {
+	var blocks []*parser.CodeBlock
+	if sel.Section == "" {
+		blocks = doc.Root.AllCodeBlocks()
+	} else {
+		tree := vast.NewContext(doc, s, "").Tree
+		seen := make(map[*parser.CodeBlock]bool)
+		for _, n := range tree.AllNodes {
+			if !n.IsBound || !matchesSection(n, sel.Section) {
+				continue
+			}
+			for _, block := range n.Section.AllCodeBlocks() {
+				if !seen[block] {
+					seen[block] = true
+					blocks = append(blocks, block)
+				}
+			}
+		}
+		slices.SortFunc(blocks, func(a, b *parser.CodeBlock) int { return cmp.Compare(a.Line, b.Line) })
+	}
+
+	snippets := make([]Snippet, 0)
+	for _, block := range blocks {
+		if sel.Lang != "" && !s.CodeBlocks.SameLang(block.Lang, sel.Lang) {
+			continue
+		}
+		snippet := Snippet{
+			Path:    path,
+			Line:    block.Line,
+			Lang:    s.CodeBlocks.Canonical(block.Lang),
+			Content: block.Content,
+		}
+		snippet.File = FileName(snippet)
+		snippets = append(snippets, snippet)
+	}
+	return snippets
+}
+
+// matchesSection reports whether a bound node is selected by name
+func matchesSection(n *vast.Node, name string) bool {
+	h := n.Element.Heading
+	if name == h.GetReadableName() {
+		return true
+	}
+	heading := n.Section.Heading
+	if heading == nil {
+		return false
+	}
+	return name == heading.Text || name == strings.Repeat("#", heading.Level)+" "+heading.Text
+}
+
+// extensions maps languages to file extensions where they differ from the
+// language name
+var extensions = map[string]string{
+	"bash":       "sh",
+	"shell":      "sh",
+	"zsh":        "sh",
+	"golang":     "go",
+	"python":     "py",
+	"ruby":       "rb",
+	"javascript": "js",
+	"typescript": "ts",
+	"rust":       "rs",
+	"yml":        "yaml",
+	"c++":        "cpp",
+	"cxx":        "cpp",
+	"c#":         "cs",
+	"csharp":     "cs",
+	"text":       "txt",
+	"":           "txt",
+}
+
+var unsafeChars = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+// FileName returns the output file name for a snippet: the document path
+// without extension, with separators replaced, followed by the line and the
+// language's extension, e.g. docs/README.md:12 (go) -> docs_README_L12.go
+func FileName(s Snippet) string {
+	base := strings.TrimSuffix(filepath.ToSlash(s.Path), filepath.Ext(s.Path))
+	base = strings.Trim(unsafeChars.ReplaceAllString(base, "_"), "_")
+
+	lang := strings.ToLower(s.Lang)
+	ext, ok := extensions[lang]
+	if !ok {
+		ext = unsafeChars.ReplaceAllString(lang, "")
+		if ext == "" {
+			ext = "txt"
+		}
+	}
+	return fmt.Sprintf("%s_L%d.%s", base, s.Line, ext)
+}
+
+// WriteManifest writes one tab-separated "file<TAB>path:line" line per
+// snippet, mapping each extracted file back to its document
+func WriteManifest(w io.Writer, snippets []Snippet) error {
+	for _, s := range snippets {
+		if _, err := fmt.Fprintf(w, "%s\t%s\n", s.File, s.Source()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckFormat reports whether a go snippet is gofmt-formatted. Snippets may
+// be whole files, declarations, or statements. Other languages are not
+// checked and always pass.
+func CheckFormat(s Snippet) (bool, error) {
+	if s.Lang != "go" && s.Lang != "golang" {
+		return true, nil
+	}
+	formatted, err := format.Source([]byte(s.Content))
+	if err != nil {
+		return false, err
+	}
+	return string(formatted) == s.Content, nil
+}
@@ -0,0 +1,127 @@
+package extract
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
+)
+
+const testDoc = "# Project\n\n```sh\necho root\n```\n\n## Usage\n\n```go\nfmt.Println(1)\n```\n\n### Advanced\n\n```go\nfmt.Println(2)\n```\n\n## Other\n\n```go\nfmt.Println(3)\n```\n"
+
+func parseTestDoc(t *testing.T) *parser.Document {
+	t.Helper()
+	doc, err := parser.New().Parse("docs/README.md", []byte(testDoc))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	return doc
+}
+
+func TestSelect(t *testing.T) {
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Pattern: "# .*"},
+				Children: []schema.StructureElement{
+					{Heading: schema.HeadingPattern{Literal: "## Usage"}, Children: []schema.StructureElement{
+						{Heading: schema.HeadingPattern{Literal: "### Advanced"}},
+					}},
+					{Heading: schema.HeadingPattern{Literal: "## Other"}},
+				},
+			},
+		},
+		CodeBlocks: &schema.CodeBlocksConfig{Aliases: map[string][]string{"bash": {"sh"}}},
+	}
+
+	tests := []struct {
+		name      string
+		sel       Selector
+		wantLines []int
+	}{
+		{"everything", Selector{}, []int{4, 10, 16, 22}},
+		{"by language", Selector{Lang: "go"}, []int{10, 16, 22}},
+		{"by alias", Selector{Lang: "bash"}, []int{4}},
+		{"by schema element includes subsections", Selector{Section: "## Usage", Lang: "go"}, []int{10, 16}},
+		{"by heading text", Selector{Section: "Other"}, []int{22}},
+		{"no match", Selector{Section: "## Missing"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			snippets := Select(parseTestDoc(t), s, tt.sel, "docs/README.md")
+			if len(snippets) != len(tt.wantLines) {
+				t.Fatalf("got %d snippets, want %d: %v", len(snippets), len(tt.wantLines), snippets)
+			}
+			for i, snippet := range snippets {
+				if snippet.Line != tt.wantLines[i] {
+					t.Errorf("snippet %d Line = %d, want %d", i, snippet.Line, tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFileName(t *testing.T) {
+	tests := []struct {
+		snippet Snippet
+		want    string
+	}{
+		{Snippet{Path: "README.md", Line: 12, Lang: "go"}, "README_L12.go"},
+		{Snippet{Path: "docs/guide/setup.md", Line: 3, Lang: "bash"}, "docs_guide_setup_L3.sh"},
+		{Snippet{Path: "a.md", Line: 1, Lang: ""}, "a_L1.txt"},
+		{Snippet{Path: "a.md", Line: 1, Lang: "c++"}, "a_L1.cpp"},
+		{Snippet{Path: "a.md", Line: 1, Lang: "cpp"}, "a_L1.cpp"},
+		{Snippet{Path: "a.md", Line: 1, Lang: "C#"}, "a_L1.cs"},
+		{Snippet{Path: "a.md", Line: 1, Lang: "objective-c"}, "a_L1.objective-c"},
+	}
+
+	for _, tt := range tests {
+		if got := FileName(tt.snippet); got != tt.want {
+			t.Errorf("FileName(%+v) = %q, want %q", tt.snippet, got, tt.want)
+		}
+	}
+}
+
+func TestWriteManifest(t *testing.T) {
+	snippets := Select(parseTestDoc(t), &schema.Schema{}, Selector{Lang: "go"}, "docs/README.md")
+
+	var b strings.Builder
+	if err := WriteManifest(&b, snippets); err != nil {
+		t.Fatalf("WriteManifest() error: %v", err)
+	}
+
+	want := "docs_README_L10.go\tdocs/README.md:10\ndocs_README_L16.go\tdocs/README.md:16\ndocs_README_L22.go\tdocs/README.md:22\n"
+	if b.String() != want {
+		t.Errorf("manifest = %q, want %q", b.String(), want)
+	}
+}
+
+func TestCheckFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		snippet Snippet
+		wantOK  bool
+		wantErr bool
+	}{
+		{"formatted file", Snippet{Lang: "go", Content: "package main\n\nfunc main() {}\n"}, true, false},
+		{"unformatted file", Snippet{Lang: "go", Content: "package main\nfunc main(){}\n"}, false, false},
+		{"formatted statements", Snippet{Lang: "go", Content: "x := 1\nfmt.Println(x)\n"}, true, false},
+		{"unformatted statements", Snippet{Lang: "go", Content: "x:=1\n"}, false, false},
+		{"syntax error", Snippet{Lang: "go", Content: "func (\n"}, false, true},
+		{"other language", Snippet{Lang: "bash", Content: "echo   hi\n"}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, err := CheckFormat(tt.snippet)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckFormat() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if ok != tt.wantOK {
+				t.Errorf("CheckFormat() = %v, want %v", ok, tt.wantOK)
+			}
+		})
+	}
+}
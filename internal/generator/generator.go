@@ -26,6 +26,9 @@ func (g *Generator) Generate(s *schema.Schema) string {
 	// Generate frontmatter if applicable
 	g.ruleGenerator.GenerateFrontmatter(&builder, s)
 
+	// Describe document-wide heading rules before the first heading
+	g.ruleGenerator.GenerateHeadingRules(&builder, s)
+
 	for _, element := range s.Structure {
 		g.generateElement(&builder, element, 1)
 	}
@@ -255,6 +255,46 @@ func TestGenerateFrontmatter(t *testing.T) {
 	}
 }
 
+func TestGenerateHeadingRules(t *testing.T) {
+	g := New()
+	on := true
+
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			SingleH1: true,
+			HeadingStyle: schema.HeadingStyle{
+				Case:                  schema.HeadingCaseSentence,
+				NoTrailingPunctuation: &on,
+			},
+		},
+		Structure: []schema.StructureElement{
+			{
+				Heading:      schema.HeadingPattern{Pattern: "# Title"},
+				HeadingRules: &schema.HeadingStyle{MaxLength: 30},
+			},
+		},
+	}
+
+	output := g.Generate(s)
+
+	for _, want := range []string{
+		"<!-- Heading rules: -->",
+		"<!-- Only one h1 heading allowed -->",
+		"<!-- Use sentence case (capitalize only the first word) -->",
+		"<!-- Do not end with punctuation (. , ; : !) -->",
+		"<!-- Maximum 30 characters -->",
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Generated output missing %q:\n%s", want, output)
+		}
+	}
+
+	// Global guidance comes before the first heading
+	if strings.Index(output, "Heading rules") > strings.Index(output, "# Title") {
+		t.Error("Global heading rules should be generated before the first heading")
+	}
+}
+
 func TestGenerateFrontmatterFormats(t *testing.T) {
 	g := New()
 
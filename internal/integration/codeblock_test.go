@@ -28,6 +28,20 @@ func TestCodeblockValidation(t *testing.T) {
 			ShouldPass:   false,
 			ExpectedRule: "codeblock",
 		},
+		{
+			Name:         "Usage with Go code that does not parse",
+			FilePath:     testdataDir + "codeblock/invalid_go_syntax.md",
+			SchemaPath:   testdataDir + "codeblock/.mdschema.yml",
+			ShouldPass:   false,
+			ExpectedRule: "codeblock",
+		},
+		{
+			Name:         "Configuration with hard-coded token",
+			FilePath:     testdataDir + "codeblock/invalid_hardcoded_token.md",
+			SchemaPath:   testdataDir + "codeblock/.mdschema.yml",
+			ShouldPass:   false,
+			ExpectedRule: "codeblock",
+		},
 	}
 
 	runTestCases(t, testCases)
@@ -43,6 +43,13 @@ func runTestCases(t *testing.T, testCases []TestCase) {
 
 			hasViolations := len(violations) > 0
 
+			// Every violation identifies the specific check that produced it
+			for _, v := range violations {
+				if v.Code == "" {
+					t.Errorf("violation without code: [%s] %s", v.Rule, v.Message)
+				}
+			}
+
 			if tc.ShouldPass && hasViolations {
 				t.Errorf("Expected document to pass validation, but found %d violation(s):", len(violations))
 				for _, v := range violations {
@@ -1,4 +1,5 @@
-// Package jsonschema provides JSON Schema generation for mdschema configuration files.
+// Package jsonschema generates the JSON Schema for mdschema configuration files
+// and validates documents such as frontmatter against JSON Schemas.
 package jsonschema
 
 import (
@@ -67,6 +68,7 @@ func Generate() ([]byte, error) {
 		{"WordCountRule", reflect.TypeOf(schema.WordCountRule{})},
 		{"ParagraphRule", reflect.TypeOf(schema.ParagraphRule{})},
 		{"CountConstraint", reflect.TypeOf(schema.CountConstraint{})},
+		{"HeadingStyle", reflect.TypeOf(schema.HeadingStyle{})},
 	}
 
 	for _, t := range additionalTypes {
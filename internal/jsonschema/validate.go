@@ -0,0 +1,715 @@
+package jsonschema
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// maxRefDepth bounds $ref resolution so that recursive schemas without a
+// base case fail instead of overflowing the stack
+const maxRefDepth = 64
+
+// Validator validates instances against a JSON Schema (draft 2020-12).
+//
+// Supported keywords: type, enum, const, the numeric, string, array and
+// object assertions, format (date, date-time, time, email, uri, uuid, ipv4,
+// ipv6, regex), allOf, anyOf, oneOf, not, if/then/else, dependentRequired,
+// dependentSchemas, $defs and $ref to the same file (JSON pointers and
+// $anchor) or to other local files. Remote references fail validation, and
+// schemas using unevaluatedProperties, unevaluatedItems or dynamic references
+// fail to compile rather than being checked partially.
+type Validator struct {
+	root  *schemaFile
+	files map[string]*schemaFile // Loaded schema files by absolute path
+}
+
+// schemaFile is a parsed schema document
+type schemaFile struct {
+	path  string
+	value any
+}
+
+// ValidationError is an instance value that violates a schema keyword
+type ValidationError struct {
+	Path    []string // Instance location as object keys and array indices
+	Keyword string   // Failing keyword, e.g. "required"
+	Message string
+}
+
+// Error returns the error with its instance location as a JSON pointer
+func (e ValidationError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pointer(), e.Message)
+}
+
+// pointerEscaper escapes a key for use as a JSON pointer segment
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// Pointer returns the instance location as a JSON pointer, e.g. /tags/0
+func (e ValidationError) Pointer() string {
+	if len(e.Path) == 0 {
+		return "/"
+	}
+	escaped := make([]string, len(e.Path))
+	for i, seg := range e.Path {
+		escaped[i] = pointerEscaper.Replace(seg)
+	}
+	return "/" + strings.Join(escaped, "/")
+}
+
+// Compile loads a JSON Schema file
+func Compile(path string) (*Validator, error) {
+	v := &Validator{files: make(map[string]*schemaFile)}
+	root, err := v.load(path)
+	if err != nil {
+		return nil, err
+	}
+	v.root = root
+	return v, nil
+}
+
+// load reads and caches a schema file
+func (v *Validator) load(path string) (*schemaFile, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	if f, ok := v.files[abs]; ok {
+		return f, nil
+	}
+
+	data, err := os.ReadFile(abs)
+	if err != nil {
+		return nil, fmt.Errorf("reading JSON Schema: %w", err)
+	}
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, fmt.Errorf("parsing JSON Schema %s: %w", path, err)
+	}
+	switch value.(type) {
+	case map[string]any, bool:
+	default:
+		return nil, fmt.Errorf("parsing JSON Schema %s: schema must be an object or a boolean", path)
+	}
+	if keyword, location := findUnsupported(value, ""); keyword != "" {
+		return nil, fmt.Errorf("parsing JSON Schema %s: unsupported keyword %q at %s", path, keyword, cmp.Or(location, "/"))
+	}
+
+	f := &schemaFile{path: abs, value: value}
+	v.files[abs] = f
+	return f, nil
+}
+
+// unsupportedKeywords are draft 2020-12 keywords the validator does not
+// implement. Ignoring them would silently accept invalid instances.
+var unsupportedKeywords = []string{
+	"unevaluatedProperties", "unevaluatedItems",
+	"$dynamicRef", "$dynamicAnchor", "$recursiveRef", "$recursiveAnchor",
+}
+
+// findUnsupported returns the first unsupported keyword in a schema and its
+// location as a JSON pointer. Only subschema positions are searched, so
+// property names and enum values are never mistaken for keywords.
+func findUnsupported(schema any, pointer string) (keyword, location string) {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return "", ""
+	}
+	for _, key := range unsupportedKeywords {
+		if _, ok := s[key]; ok {
+			return key, pointer
+		}
+	}
+
+	for _, key := range sortedKeys(s) {
+		escaped := pointer + "/" + pointerEscaper.Replace(key)
+		switch key {
+		case "not", "if", "then", "else", "items", "contains", "additionalProperties", "propertyNames":
+			if keyword, location := findUnsupported(s[key], escaped); keyword != "" {
+				return keyword, location
+			}
+		case "allOf", "anyOf", "oneOf", "prefixItems":
+			list, _ := s[key].([]any)
+			for i, sub := range list {
+				if keyword, location := findUnsupported(sub, escaped+"/"+strconv.Itoa(i)); keyword != "" {
+					return keyword, location
+				}
+			}
+		case "properties", "patternProperties", "dependentSchemas", "$defs", "definitions":
+			subs, _ := s[key].(map[string]any)
+			for _, name := range sortedKeys(subs) {
+				sub := escaped + "/" + pointerEscaper.Replace(name)
+				if keyword, location := findUnsupported(subs[name], sub); keyword != "" {
+					return keyword, location
+				}
+			}
+		}
+	}
+	return "", ""
+}
+
+// Validate validates an instance decoded from JSON or YAML. Errors are
+// sorted by instance location.
+func (v *Validator) Validate(instance any) []ValidationError {
+	errs := v.validate(v.root.value, v.root, normalize(instance), nil, 0)
+	sort.SliceStable(errs, func(i, j int) bool {
+		return slices.Compare(errs[i].Path, errs[j].Path) < 0
+	})
+	return errs
+}
+
+// normalize converts decoded YAML into the JSON data model: maps with
+// string keys, float64 numbers, and dates as strings
+func normalize(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, elem := range val {
+			out[k] = normalize(elem)
+		}
+		return out
+	case map[any]any:
+		out := make(map[string]any, len(val))
+		for k, elem := range val {
+			out[fmt.Sprint(k)] = normalize(elem)
+		}
+		return out
+	case []any:
+		out := make([]any, len(val))
+		for i, elem := range val {
+			out[i] = normalize(elem)
+		}
+		return out
+	case int:
+		return float64(val)
+	case int64:
+		return float64(val)
+	case uint64:
+		return float64(val)
+	case float32:
+		return float64(val)
+	case time.Time:
+		if val.Hour() == 0 && val.Minute() == 0 && val.Second() == 0 && val.Nanosecond() == 0 {
+			return val.Format(time.DateOnly)
+		}
+		return val.Format(time.RFC3339)
+	}
+	return v
+}
+
+// validate applies a schema to an instance at path
+func (v *Validator) validate(schema any, file *schemaFile, inst any, path []string, depth int) []ValidationError {
+	switch s := schema.(type) {
+	case bool:
+		if !s {
+			return []ValidationError{newError(path, "false", "value is not allowed")}
+		}
+		return nil
+	case map[string]any:
+		var errs []ValidationError
+		if ref, ok := s["$ref"].(string); ok {
+			errs = append(errs, v.validateRef(ref, file, inst, path, depth)...)
+		}
+		errs = append(errs, v.validateGeneric(s, inst, path)...)
+		errs = append(errs, v.validateApplicators(s, file, inst, path, depth)...)
+		switch val := inst.(type) {
+		case string:
+			errs = append(errs, validateString(s, val, path)...)
+		case float64:
+			errs = append(errs, validateNumber(s, val, path)...)
+		case []any:
+			errs = append(errs, v.validateArray(s, file, val, path, depth)...)
+		case map[string]any:
+			errs = append(errs, v.validateObject(s, file, val, path, depth)...)
+		}
+		return errs
+	}
+	return nil
+}
+
+// valid reports whether an instance matches a subschema
+func (v *Validator) valid(schema any, file *schemaFile, inst any, path []string, depth int) bool {
+	return len(v.validate(schema, file, inst, path, depth)) == 0
+}
+
+func newError(path []string, keyword, format string, args ...any) ValidationError {
+	return ValidationError{Path: slices.Clone(path), Keyword: keyword, Message: fmt.Sprintf(format, args...)}
+}
+
+// validateRef resolves a reference and validates the instance against it
+func (v *Validator) validateRef(ref string, file *schemaFile, inst any, path []string, depth int) []ValidationError {
+	if depth >= maxRefDepth {
+		return []ValidationError{newError(path, "$ref", "reference %q nests too deeply", ref)}
+	}
+	target, targetFile, err := v.resolve(ref, file)
+	if err != nil {
+		return []ValidationError{newError(path, "$ref", "%v", err)}
+	}
+	return v.validate(target, targetFile, inst, path, depth+1)
+}
+
+// resolve finds the subschema a $ref points at: a JSON pointer or $anchor
+// within a schema file, optionally preceded by a local file path
+func (v *Validator) resolve(ref string, file *schemaFile) (any, *schemaFile, error) {
+	location, fragment, _ := strings.Cut(ref, "#")
+	if location != "" {
+		u, err := url.Parse(location)
+		if err != nil || (u.Scheme != "" && u.Scheme != "file") {
+			return nil, nil, fmt.Errorf("unsupported reference %q (only local files are supported)", ref)
+		}
+		p := u.Path
+		if !filepath.IsAbs(p) {
+			p = filepath.Join(filepath.Dir(file.path), p)
+		}
+		loaded, err := v.load(p)
+		if err != nil {
+			return nil, nil, err
+		}
+		file = loaded
+	}
+
+	if fragment == "" {
+		return file.value, file, nil
+	}
+	if strings.HasPrefix(fragment, "/") {
+		target, err := resolvePointer(file.value, fragment)
+		if err != nil {
+			return nil, nil, fmt.Errorf("resolving %q: %w", ref, err)
+		}
+		return target, file, nil
+	}
+	if target := findAnchor(file.value, fragment); target != nil {
+		return target, file, nil
+	}
+	return nil, nil, fmt.Errorf("resolving %q: anchor not found", ref)
+}
+
+// resolvePointer follows a JSON pointer such as /$defs/tag
+func resolvePointer(doc any, pointer string) (any, error) {
+	current := doc
+	for _, raw := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		seg, err := url.PathUnescape(raw)
+		if err != nil {
+			seg = raw
+		}
+		seg = strings.NewReplacer("~1", "/", "~0", "~").Replace(seg)
+		switch node := current.(type) {
+		case map[string]any:
+			next, ok := node[seg]
+			if !ok {
+				return nil, fmt.Errorf("%q not found", seg)
+			}
+			current = next
+		case []any:
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("index %q out of range", seg)
+			}
+			current = node[i]
+		default:
+			return nil, fmt.Errorf("%q not found", seg)
+		}
+	}
+	return current, nil
+}
+
+// findAnchor finds the subschema declaring "$anchor": name
+func findAnchor(node any, name string) any {
+	switch n := node.(type) {
+	case map[string]any:
+		if anchor, ok := n["$anchor"].(string); ok && anchor == name {
+			return n
+		}
+		for _, child := range n {
+			if found := findAnchor(child, name); found != nil {
+				return found
+			}
+		}
+	case []any:
+		for _, child := range n {
+			if found := findAnchor(child, name); found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// validateGeneric applies type, enum and const
+func (v *Validator) validateGeneric(s map[string]any, inst any, path []string) []ValidationError {
+	var errs []ValidationError
+
+	if t, ok := s["type"]; ok {
+		var allowed []string
+		switch tv := t.(type) {
+		case string:
+			allowed = []string{tv}
+		case []any:
+			for _, item := range tv {
+				if name, ok := item.(string); ok {
+					allowed = append(allowed, name)
+				}
+			}
+		}
+		if len(allowed) > 0 && !slices.ContainsFunc(allowed, func(name string) bool { return hasType(inst, name) }) {
+			errs = append(errs, newError(path, "type", "must be %s, found %s", strings.Join(allowed, " or "), typeName(inst)))
+		}
+	}
+
+	if enum, ok := s["enum"].([]any); ok {
+		if !slices.ContainsFunc(enum, func(allowed any) bool { return reflect.DeepEqual(allowed, inst) }) {
+			errs = append(errs, newError(path, "enum", "must be one of %s, found %s", formatValues(enum), formatValue(inst)))
+		}
+	}
+
+	if c, ok := s["const"]; ok && !reflect.DeepEqual(c, inst) {
+		errs = append(errs, newError(path, "const", "must be %s, found %s", formatValue(c), formatValue(inst)))
+	}
+
+	return errs
+}
+
+// validateApplicators applies allOf, anyOf, oneOf, not, if/then/else and
+// dependentSchemas
+func (v *Validator) validateApplicators(s map[string]any, file *schemaFile, inst any, path []string, depth int) []ValidationError {
+	var errs []ValidationError
+
+	if all, ok := s["allOf"].([]any); ok {
+		for _, sub := range all {
+			errs = append(errs, v.validate(sub, file, inst, path, depth)...)
+		}
+	}
+
+	if anyOf, ok := s["anyOf"].([]any); ok {
+		if !slices.ContainsFunc(anyOf, func(sub any) bool { return v.valid(sub, file, inst, path, depth) }) {
+			errs = append(errs, newError(path, "anyOf", "does not match any of the allowed schemas"))
+		}
+	}
+
+	if oneOf, ok := s["oneOf"].([]any); ok {
+		matches := 0
+		for _, sub := range oneOf {
+			if v.valid(sub, file, inst, path, depth) {
+				matches++
+			}
+		}
+		if matches != 1 {
+			errs = append(errs, newError(path, "oneOf", "must match exactly one of the allowed schemas, matches %d", matches))
+		}
+	}
+
+	if not, ok := s["not"]; ok && v.valid(not, file, inst, path, depth) {
+		errs = append(errs, newError(path, "not", "must not match the disallowed schema"))
+	}
+
+	if cond, ok := s["if"]; ok {
+		if v.valid(cond, file, inst, path, depth) {
+			if then, ok := s["then"]; ok {
+				errs = append(errs, v.validate(then, file, inst, path, depth)...)
+			}
+		} else if otherwise, ok := s["else"]; ok {
+			errs = append(errs, v.validate(otherwise, file, inst, path, depth)...)
+		}
+	}
+
+	if deps, ok := s["dependentSchemas"].(map[string]any); ok {
+		if obj, ok := inst.(map[string]any); ok {
+			for _, name := range sortedKeys(deps) {
+				if _, present := obj[name]; present {
+					errs = append(errs, v.validate(deps[name], file, inst, path, depth)...)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateString applies string length, pattern and format
+func validateString(s map[string]any, val string, path []string) []ValidationError {
+	var errs []ValidationError
+	length := utf8.RuneCountInString(val)
+
+	if n, ok := number(s["minLength"]); ok && float64(length) < n {
+		errs = append(errs, newError(path, "minLength", "must be at least %v characters, found %d", n, length))
+	}
+	if n, ok := number(s["maxLength"]); ok && float64(length) > n {
+		errs = append(errs, newError(path, "maxLength", "must be at most %v characters, found %d", n, length))
+	}
+	if pattern, ok := s["pattern"].(string); ok {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			errs = append(errs, newError(path, "pattern", "invalid pattern %q in schema: %v", pattern, err))
+		} else if !re.MatchString(val) {
+			errs = append(errs, newError(path, "pattern", "must match pattern %q", pattern))
+		}
+	}
+	if format, ok := s["format"].(string); ok && !validFormat(format, val) {
+		errs = append(errs, newError(path, "format", "must be a valid %s, found %q", format, val))
+	}
+
+	return errs
+}
+
+// validFormat checks the formats mdschema asserts; unknown formats pass
+func validFormat(format, val string) bool {
+	switch format {
+	case "date":
+		_, err := time.Parse(time.DateOnly, val)
+		return err == nil
+	case "date-time":
+		_, err := time.Parse(time.RFC3339, val)
+		return err == nil
+	case "time":
+		_, err := time.Parse("15:04:05Z07:00", val)
+		return err == nil
+	case "email":
+		addr, err := mail.ParseAddress(val)
+		return err == nil && addr.Address == val
+	case "uri":
+		u, err := url.Parse(val)
+		return err == nil && u.Scheme != ""
+	case "uuid":
+		return uuidRegex.MatchString(val)
+	case "ipv4":
+		ip := net.ParseIP(val)
+		return ip != nil && ip.To4() != nil && !strings.Contains(val, ":")
+	case "ipv6":
+		ip := net.ParseIP(val)
+		return ip != nil && strings.Contains(val, ":")
+	case "regex":
+		_, err := regexp.Compile(val)
+		return err == nil
+	}
+	return true
+}
+
+var uuidRegex = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// validateNumber applies numeric bounds and multipleOf
+func validateNumber(s map[string]any, val float64, path []string) []ValidationError {
+	var errs []ValidationError
+
+	if n, ok := number(s["minimum"]); ok && val < n {
+		errs = append(errs, newError(path, "minimum", "must be >= %v, found %v", n, val))
+	}
+	if n, ok := number(s["maximum"]); ok && val > n {
+		errs = append(errs, newError(path, "maximum", "must be <= %v, found %v", n, val))
+	}
+	if n, ok := number(s["exclusiveMinimum"]); ok && val <= n {
+		errs = append(errs, newError(path, "exclusiveMinimum", "must be > %v, found %v", n, val))
+	}
+	if n, ok := number(s["exclusiveMaximum"]); ok && val >= n {
+		errs = append(errs, newError(path, "exclusiveMaximum", "must be < %v, found %v", n, val))
+	}
+	if n, ok := number(s["multipleOf"]); ok && n > 0 {
+		if q := val / n; math.Abs(q-math.Round(q)) > 1e-9 {
+			errs = append(errs, newError(path, "multipleOf", "must be a multiple of %v, found %v", n, val))
+		}
+	}
+
+	return errs
+}
+
+// validateArray applies item schemas, length, uniqueness and contains
+func (v *Validator) validateArray(s map[string]any, file *schemaFile, arr []any, path []string, depth int) []ValidationError {
+	var errs []ValidationError
+
+	prefix, _ := s["prefixItems"].([]any)
+	for i, sub := range prefix {
+		if i < len(arr) {
+			errs = append(errs, v.validate(sub, file, arr[i], append(path, strconv.Itoa(i)), depth)...)
+		}
+	}
+	if items, ok := s["items"]; ok {
+		for i := len(prefix); i < len(arr); i++ {
+			errs = append(errs, v.validate(items, file, arr[i], append(path, strconv.Itoa(i)), depth)...)
+		}
+	}
+
+	if n, ok := number(s["minItems"]); ok && float64(len(arr)) < n {
+		errs = append(errs, newError(path, "minItems", "must have at least %v items, found %d", n, len(arr)))
+	}
+	if n, ok := number(s["maxItems"]); ok && float64(len(arr)) > n {
+		errs = append(errs, newError(path, "maxItems", "must have at most %v items, found %d", n, len(arr)))
+	}
+	if unique, _ := s["uniqueItems"].(bool); unique {
+		for i := range arr {
+			if slices.ContainsFunc(arr[:i], func(prev any) bool { return reflect.DeepEqual(prev, arr[i]) }) {
+				errs = append(errs, newError(append(path, strconv.Itoa(i)), "uniqueItems", "duplicate item %s", formatValue(arr[i])))
+			}
+		}
+	}
+
+	if contains, ok := s["contains"]; ok {
+		matches := 0
+		for i, elem := range arr {
+			if v.valid(contains, file, elem, append(path, strconv.Itoa(i)), depth) {
+				matches++
+			}
+		}
+		minContains := 1.0
+		if n, ok := number(s["minContains"]); ok {
+			minContains = n
+		}
+		if float64(matches) < minContains {
+			errs = append(errs, newError(path, "contains", "must contain at least %v matching items, found %d", minContains, matches))
+		}
+		if n, ok := number(s["maxContains"]); ok && float64(matches) > n {
+			errs = append(errs, newError(path, "maxContains", "must contain at most %v matching items, found %d", n, matches))
+		}
+	}
+
+	return errs
+}
+
+// validateObject applies property schemas, required and property counts.
+// Errors about a specific property are located at that property.
+func (v *Validator) validateObject(s map[string]any, file *schemaFile, obj map[string]any, path []string, depth int) []ValidationError {
+	var errs []ValidationError
+	keys := sortedKeys(obj)
+
+	if required, ok := s["required"].([]any); ok {
+		for _, r := range required {
+			if name, ok := r.(string); ok {
+				if _, present := obj[name]; !present {
+					errs = append(errs, newError(path, "required", "missing required property %q", name))
+				}
+			}
+		}
+	}
+
+	if deps, ok := s["dependentRequired"].(map[string]any); ok {
+		for _, name := range sortedKeys(deps) {
+			if _, present := obj[name]; !present {
+				continue
+			}
+			required, _ := deps[name].([]any)
+			for _, r := range required {
+				if dep, ok := r.(string); ok {
+					if _, present := obj[dep]; !present {
+						errs = append(errs, newError(path, "dependentRequired", "property %q requires property %q", name, dep))
+					}
+				}
+			}
+		}
+	}
+
+	if n, ok := number(s["minProperties"]); ok && float64(len(obj)) < n {
+		errs = append(errs, newError(path, "minProperties", "must have at least %v properties, found %d", n, len(obj)))
+	}
+	if n, ok := number(s["maxProperties"]); ok && float64(len(obj)) > n {
+		errs = append(errs, newError(path, "maxProperties", "must have at most %v properties, found %d", n, len(obj)))
+	}
+
+	props, _ := s["properties"].(map[string]any)
+	patternProps, _ := s["patternProperties"].(map[string]any)
+	additional, hasAdditional := s["additionalProperties"]
+	propertyNames, hasPropertyNames := s["propertyNames"]
+
+	for _, key := range keys {
+		keyPath := append(slices.Clone(path), key)
+		evaluated := false
+
+		if sub, ok := props[key]; ok {
+			evaluated = true
+			errs = append(errs, v.validate(sub, file, obj[key], keyPath, depth)...)
+		}
+		for _, pattern := range sortedKeys(patternProps) {
+			re, err := regexp.Compile(pattern)
+			if err != nil || !re.MatchString(key) {
+				continue
+			}
+			evaluated = true
+			errs = append(errs, v.validate(patternProps[pattern], file, obj[key], keyPath, depth)...)
+		}
+		if !evaluated && hasAdditional {
+			if allowed, ok := additional.(bool); ok && !allowed {
+				errs = append(errs, newError(keyPath, "additionalProperties", "is not an allowed property"))
+			} else {
+				errs = append(errs, v.validate(additional, file, obj[key], keyPath, depth)...)
+			}
+		}
+		if hasPropertyNames && !v.valid(propertyNames, file, key, keyPath, depth) {
+			errs = append(errs, newError(keyPath, "propertyNames", "is not an allowed property name"))
+		}
+	}
+
+	return errs
+}
+
+// hasType reports whether an instance is of a JSON Schema type
+func hasType(inst any, name string) bool {
+	switch name {
+	case "integer":
+		n, ok := inst.(float64)
+		return ok && n == math.Trunc(n)
+	case "number":
+		_, ok := inst.(float64)
+		return ok
+	}
+	return typeName(inst) == name
+}
+
+// typeName returns the JSON type of a normalized instance
+func typeName(inst any) string {
+	switch inst.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case float64:
+		return "number"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", inst)
+}
+
+func number(v any) (float64, bool) {
+	n, ok := v.(float64)
+	return n, ok
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
+
+func formatValues(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = formatValue(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
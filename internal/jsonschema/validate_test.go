@@ -0,0 +1,157 @@
+package jsonschema
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeSchema(t *testing.T, dir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatalf("writing schema: %v", err)
+	}
+	return path
+}
+
+func TestValidate(t *testing.T) {
+	dir := t.TempDir()
+	writeSchema(t, dir, "common.json", `{
+  "$defs": {
+    "slug": {"type": "string", "pattern": "^[a-z0-9-]+$"}
+  }
+}`)
+	path := writeSchema(t, dir, "page.schema.json", `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "required": ["title"],
+  "properties": {
+    "title": {"type": "string", "minLength": 3},
+    "weight": {"type": "integer", "minimum": 0, "exclusiveMaximum": 100},
+    "draft": {"type": "boolean"},
+    "date": {"type": "string", "format": "date"},
+    "tags": {"type": "array", "items": {"$ref": "#/$defs/tag"}, "uniqueItems": true, "maxItems": 3},
+    "slug": {"$ref": "common.json#/$defs/slug"},
+    "layout": {"enum": ["page", "post"]},
+    "author": {
+      "type": "object",
+      "properties": {"email": {"type": "string", "format": "email"}},
+      "additionalProperties": false
+    },
+    "kind": {"oneOf": [{"const": "guide"}, {"const": "reference"}]}
+  },
+  "if": {"properties": {"draft": {"const": false}}, "required": ["draft"]},
+  "then": {"required": ["date"]},
+  "$defs": {
+    "tag": {"$anchor": "tag", "type": "string", "maxLength": 10}
+  }
+}`)
+
+	v, err := Compile(path)
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		instance map[string]any
+		want     []string // "pointer keyword" pairs
+	}{
+		{"valid", map[string]any{"title": "Hello", "weight": 10, "tags": []any{"go"}, "slug": "hello-world", "layout": "page", "kind": "guide"}, nil},
+		{"required", map[string]any{}, []string{"/ required"}},
+		{"type and length", map[string]any{"title": "Hi", "draft": "yes"}, []string{"/draft type", "/title minLength"}},
+		{"integer and bounds", map[string]any{"title": "Hello", "weight": 1.5}, []string{"/weight type"}},
+		{"exclusive maximum", map[string]any{"title": "Hello", "weight": 100}, []string{"/weight exclusiveMaximum"}},
+		{"items via ref", map[string]any{"title": "Hello", "tags": []any{"go", "a-very-long-tag", "go"}}, []string{"/tags/1 maxLength", "/tags/2 uniqueItems"}},
+		{"cross-file ref", map[string]any{"title": "Hello", "slug": "Not A Slug"}, []string{"/slug pattern"}},
+		{"enum", map[string]any{"title": "Hello", "layout": "home"}, []string{"/layout enum"}},
+		{"nested additional properties and format", map[string]any{"title": "Hello", "author": map[any]any{"email": "nope", "name": "x"}}, []string{"/author/email format", "/author/name additionalProperties"}},
+		{"oneOf", map[string]any{"title": "Hello", "kind": "tutorial"}, []string{"/kind oneOf"}},
+		{"if then", map[string]any{"title": "Hello", "draft": false}, []string{"/ required"}},
+		{"dates from yaml", map[string]any{"title": "Hello", "date": time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, e := range v.Validate(tt.instance) {
+				got = append(got, e.Pointer()+" "+e.Keyword)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Validate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	if _, err := Compile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("Compile() should fail for a missing file")
+	}
+	if _, err := Compile(writeSchema(t, dir, "bad.json", `{"type": `)); err == nil {
+		t.Error("Compile() should fail for invalid JSON")
+	}
+	if _, err := Compile(writeSchema(t, dir, "array.json", `[]`)); err == nil {
+		t.Error("Compile() should fail for a schema that is not an object")
+	}
+
+	v, err := Compile(writeSchema(t, dir, "remote.json", `{"$ref": "https://example.com/schema.json"}`))
+	if err != nil {
+		t.Fatalf("Compile() error: %v", err)
+	}
+	if errs := v.Validate(map[string]any{}); len(errs) != 1 || errs[0].Keyword != "$ref" {
+		t.Errorf("remote $ref should fail validation with a $ref error, got %v", errs)
+	}
+}
+
+func TestCompileUnsupportedKeywords(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := []struct {
+		name    string
+		schema  string
+		wantErr string // Substring of the error, "" for none
+	}{
+		{
+			name:    "unevaluatedProperties at the root",
+			schema:  `{"type": "object", "unevaluatedProperties": false}`,
+			wantErr: `unsupported keyword "unevaluatedProperties" at /`,
+		},
+		{
+			name:    "unevaluatedItems in a nested subschema",
+			schema:  `{"properties": {"tags": {"allOf": [{"unevaluatedItems": false}]}}}`,
+			wantErr: `unsupported keyword "unevaluatedItems" at /properties/tags/allOf/0`,
+		},
+		{
+			name:    "dynamic reference in $defs",
+			schema:  `{"$defs": {"node": {"$dynamicRef": "#node"}}}`,
+			wantErr: `unsupported keyword "$dynamicRef" at /$defs/node`,
+		},
+		{
+			name:   "keyword names as property names and values",
+			schema: `{"properties": {"unevaluatedItems": {"enum": [{"unevaluatedProperties": false}]}}}`,
+		},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(writeSchema(t, dir, fmt.Sprintf("schema%d.json", i), tt.schema))
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Compile() error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Compile() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -7,17 +7,35 @@ import (
 
 	"github.com/yuin/goldmark/ast"
 	east "github.com/yuin/goldmark/extension/ast"
+	"gopkg.in/yaml.v3"
 )
 
 func extractHeading(node *ast.Heading, content []byte) *Heading {
 	// Use ast.Walk to recursively extract all text (handles emphasis, code, links, etc.)
-	var textBuf bytes.Buffer
+	// Text inside code spans is kept out of proseBuf so style checks skip it.
+	var textBuf, proseBuf bytes.Buffer
+	var hasEmphasis, hasCode bool
+	inCode := 0
 	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		switch n.(type) {
+		case *ast.CodeSpan:
+			hasCode = true
+			if entering {
+				inCode++
+			} else {
+				inCode--
+			}
+		case *ast.Emphasis:
+			hasEmphasis = true
+		}
 		if !entering {
 			return ast.WalkContinue, nil
 		}
 		if t, ok := n.(*ast.Text); ok {
 			textBuf.Write(t.Segment.Value(content))
+			if inCode == 0 {
+				proseBuf.Write(t.Segment.Value(content))
+			}
 		}
 		return ast.WalkContinue, nil
 	})
@@ -26,41 +44,137 @@ func extractHeading(node *ast.Heading, content []byte) *Heading {
 	line, col := getPosition(node, content)
 
 	return &Heading{
-		Level:  node.Level,
-		Text:   text,
-		Line:   line,
-		Column: col,
-		Slug:   GenerateSlug(text),
+		Level:       node.Level,
+		Text:        text,
+		Line:        line,
+		Column:      col,
+		Slug:        GenerateSlug(text),
+		Prose:       strings.TrimSpace(proseBuf.String()),
+		HasEmphasis: hasEmphasis,
+		HasCode:     hasCode,
 	}
 }
 
 func extractCodeBlock(node *ast.FencedCodeBlock, content []byte) *CodeBlock {
-	var lang string
+	var info string
 	if node.Info != nil {
-		lang = string(node.Info.Segment.Value(content))
+		info = string(node.Info.Segment.Value(content))
+	}
+	lang, attrs := parseInfoString(info)
+
+	var body bytes.Buffer
+	lines := node.Lines()
+	for i := 0; i < lines.Len(); i++ {
+		seg := lines.At(i)
+		body.Write(seg.Value(content))
 	}
 
 	line, col := getPosition(node, content)
 	return &CodeBlock{
-		Lang:   lang,
-		Line:   line,
-		Column: col,
+		Lang:       lang,
+		Info:       info,
+		Attributes: attrs,
+		Content:    body.String(),
+		Line:       line,
+		Column:     col,
+	}
+}
+
+// parseInfoString splits a fence info string such as
+// `go title="main.go" showLineNumbers {3-5}` into the language and its
+// attributes. Values may be quoted; brace groups like {3-5} (line
+// highlighting) are not attributes.
+func parseInfoString(info string) (string, map[string]string) {
+	fields := splitInfoFields(info)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	lang := fields[0]
+	// Some tools write the first attribute group directly after the language
+	if i := strings.IndexByte(lang, '{'); i > 0 {
+		fields = append([]string{lang[:i], lang[i:]}, fields[1:]...)
+		lang = lang[:i]
+	}
+
+	var attrs map[string]string
+	for _, field := range fields[1:] {
+		if strings.HasPrefix(field, "{") {
+			continue
+		}
+		if attrs == nil {
+			attrs = make(map[string]string)
+		}
+		key, value, _ := strings.Cut(field, "=")
+		attrs[key] = strings.Trim(value, `"'`)
 	}
+	return lang, attrs
 }
 
-// extractFrontmatterLinks extracts link-like values from frontmatter data.
-func extractFrontmatterLinks(data map[string]any) []*Link {
+// splitInfoFields splits s on whitespace, keeping quoted values and brace
+// groups together
+func splitInfoFields(s string) []string {
+	var fields []string
+	var current strings.Builder
+	var quote rune
+	for _, r := range s {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			}
+			current.WriteRune(r)
+		case r == '"' || r == '\'':
+			quote = r
+			current.WriteRune(r)
+		case r == '{':
+			quote = '}'
+			current.WriteRune(r)
+		case r == ' ' || r == '\t':
+			if current.Len() > 0 {
+				fields = append(fields, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		fields = append(fields, current.String())
+	}
+	return fields
+}
+
+// extractFrontmatterLinks extracts link-like values from top-level
+// frontmatter fields. Links are positioned at their value when the YAML node
+// tree is available, and at line 1 otherwise.
+func extractFrontmatterLinks(data map[string]any, node *yaml.Node) []*Link {
 	if data == nil {
 		return nil
 	}
 
 	var links []*Link
+	if node != nil {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value := node.Content[i+1]
+			if value.Kind == yaml.ScalarNode && value.Tag == "!!str" && isLinkValue(value.Value) {
+				links = append(links, &Link{
+					URL:        value.Value,
+					IsInternal: isInternalLink(value.Value),
+					Line:       value.Line,
+					Column:     value.Column,
+				})
+			}
+		}
+		return links
+	}
+
 	for _, value := range data {
 		str, ok := value.(string)
 		if !ok {
 			continue
 		}
-		if strings.HasPrefix(str, "#") || strings.HasPrefix(str, "http://") || strings.HasPrefix(str, "https://") {
+		if isLinkValue(str) {
 			links = append(links, &Link{
 				URL:        str,
 				IsInternal: isInternalLink(str),
@@ -72,6 +186,11 @@ func extractFrontmatterLinks(data map[string]any) []*Link {
 	return links
 }
 
+// isLinkValue reports whether a frontmatter string looks like a link
+func isLinkValue(s string) bool {
+	return strings.HasPrefix(s, "#") || strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
 func extractLink(node *ast.Link, content []byte) *Link {
 	// Use ast.Walk to recursively extract all text (handles emphasis, code, etc.)
 	var textBuf bytes.Buffer
@@ -98,19 +217,82 @@ func extractLink(node *ast.Link, content []byte) *Link {
 }
 
 func extractList(node *ast.List, content []byte) *List {
+	level := 1
+	for p := node.Parent(); p != nil; p = p.Parent() {
+		if p.Kind() == ast.KindList {
+			level++
+		}
+	}
+
 	line, col := getPosition(node, content)
 	list := &List{
 		IsOrdered: node.IsOrdered(),
+		Level:     level,
+		Items:     make([]*ListItem, 0),
 		Line:      line,
 		Column:    col,
 	}
 
+	for child := node.FirstChild(); child != nil; child = child.NextSibling() {
+		if item, ok := child.(*ast.ListItem); ok {
+			list.Items = append(list.Items, extractListItem(item, level, content))
+		}
+	}
+
 	return list
 }
 
+func extractListItem(node *ast.ListItem, level int, content []byte) *ListItem {
+	item := &ListItem{Level: level}
+
+	var textBuf bytes.Buffer
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch n := n.(type) {
+		case *ast.List:
+			// Nested lists are extracted on their own
+			return ast.WalkSkipChildren, nil
+		case *east.TaskCheckBox:
+			item.IsTask = true
+			item.Checked = n.IsChecked
+		case *ast.Text:
+			textBuf.Write(n.Segment.Value(content))
+			if n.SoftLineBreak() || n.HardLineBreak() {
+				textBuf.WriteByte(' ')
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	item.Text = strings.TrimSpace(textBuf.String())
+
+	// Position of the list marker
+	item.Line, item.Column = 1, 1
+	if node.Pos() >= 0 {
+		item.Line, item.Column = calculateLineColumn(content, node.Pos())
+	}
+	return item
+}
+
 func extractParagraph(node *ast.Paragraph, content []byte) *Paragraph {
+	var textBuf bytes.Buffer
+	_ = ast.Walk(node, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if t, ok := n.(*ast.Text); ok {
+			textBuf.Write(t.Segment.Value(content))
+			if t.SoftLineBreak() || t.HardLineBreak() {
+				textBuf.WriteByte(' ')
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+
 	line, col := getPosition(node, content)
 	return &Paragraph{
+		Text:   strings.TrimSpace(textBuf.String()),
 		Line:   line,
 		Column: col,
 	}
@@ -118,36 +300,52 @@ func extractParagraph(node *ast.Paragraph, content []byte) *Paragraph {
 
 func extractTable(node *east.Table, content []byte) *Table {
 	headers := make([]string, 0)
+	rows := make([]*TableRow, 0)
 
-	// Extract headers from first row
-	if node.FirstChild() != nil && node.FirstChild().Kind() == east.KindTableHeader {
-		headerRow := node.FirstChild()
-		for cell := headerRow.FirstChild(); cell != nil; cell = cell.NextSibling() {
-			var textBuf bytes.Buffer
-			if err := ast.Walk(cell, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
-				if !entering {
-					return ast.WalkContinue, nil
-				}
-				if t, ok := n.(*ast.Text); ok {
-					textBuf.Write(t.Segment.Value(content))
-				}
-				return ast.WalkContinue, nil
-			}); err != nil {
-				fmt.Printf("Error extracting table header: %v\n", err)
-				continue
-			}
-			headers = append(headers, strings.TrimSpace(textBuf.String()))
+	line, col := getPosition(node, content)
+	for row := node.FirstChild(); row != nil; row = row.NextSibling() {
+		cells := make([]string, 0)
+		for cell := row.FirstChild(); cell != nil; cell = cell.NextSibling() {
+			cells = append(cells, extractCellText(cell, content))
 		}
+
+		// Extract headers from first row
+		if row.Kind() == east.KindTableHeader {
+			headers = cells
+			continue
+		}
+		rowLine, _ := getPosition(row, content)
+		rows = append(rows, &TableRow{
+			Cells: cells,
+			Line:  rowLine,
+		})
 	}
 
-	line, col := getPosition(node, content)
 	return &Table{
 		Headers: headers,
+		Rows:    rows,
 		Line:    line,
 		Column:  col,
 	}
 }
 
+// extractCellText returns the trimmed text of a table cell
+func extractCellText(cell ast.Node, content []byte) string {
+	var textBuf bytes.Buffer
+	if err := ast.Walk(cell, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		if t, ok := n.(*ast.Text); ok {
+			textBuf.Write(t.Segment.Value(content))
+		}
+		return ast.WalkContinue, nil
+	}); err != nil {
+		fmt.Printf("Error extracting table cell: %v\n", err)
+	}
+	return strings.TrimSpace(textBuf.String())
+}
+
 func extractImage(node *ast.Image, content []byte) *Image {
 	// Use ast.Walk to recursively extract all alt text (handles emphasis, code, etc.)
 	var altBuf bytes.Buffer
@@ -0,0 +1,485 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// Frontmatter formats, as reported in FrontMatter.Format
+const (
+	FormatYAML = "yaml"
+	FormatTOML = "toml"
+	FormatJSON = "json"
+)
+
+// frontmatterDelimiters maps the line that opens and closes a frontmatter
+// block to its format. JSON frontmatter may also be a bare object whose
+// braces are on lines of their own (see detectFrontmatter).
+var frontmatterDelimiters = map[string]string{
+	"---": FormatYAML,
+	"+++": FormatTOML,
+	";;;": FormatJSON,
+}
+
+// frontmatterBlock is a frontmatter block at the start of a document
+type frontmatterBlock struct {
+	format    string
+	source    string // Text between the delimiters (the whole object for bare JSON)
+	firstLine int    // Document line of the first line of source
+	end       int    // Byte offset just past the closing line
+}
+
+// detectFrontmatter finds the frontmatter block a document starts with. It
+// reports false when the document does not start with one.
+func detectFrontmatter(content []byte) (frontmatterBlock, bool) {
+	lines := bytes.SplitAfter(content, []byte("\n"))
+	if len(lines) == 0 {
+		return frontmatterBlock{}, false
+	}
+	opening := string(bytes.TrimRight(lines[0], " \t\r\n"))
+
+	// A bare JSON object spans from "{" to the line with its matching "}"
+	if opening == "{" {
+		last := jsonObjectEnd(lines)
+		if last < 0 {
+			return frontmatterBlock{}, false
+		}
+		source := string(bytes.Join(lines[:last+1], nil))
+		return frontmatterBlock{format: FormatJSON, source: source, firstLine: 1, end: len(source)}, true
+	}
+
+	format, ok := frontmatterDelimiters[opening]
+	if !ok {
+		return frontmatterBlock{}, false
+	}
+	var body bytes.Buffer
+	end := len(lines[0])
+	for _, line := range lines[1:] {
+		end += len(line)
+		if string(bytes.TrimRight(line, " \t\r\n")) == opening {
+			return frontmatterBlock{format: format, source: body.String(), firstLine: 2, end: end}, true
+		}
+		body.Write(line)
+	}
+	return frontmatterBlock{}, false
+}
+
+// jsonObjectEnd returns the index of the line that closes the JSON object
+// opened on the first line, skipping braces inside strings. If the braces
+// never balance, the first line that is just "}" ends the block so the
+// malformed object is still reported as frontmatter. It returns -1 when
+// there is no end at all.
+func jsonObjectEnd(lines [][]byte) int {
+	depth := 0
+	inString, escaped := false, false
+	for i, line := range lines {
+		for _, c := range line {
+			switch {
+			case escaped:
+				escaped = false
+			case inString && c == '\\':
+				escaped = true
+			case c == '"':
+				inString = !inString
+			case inString:
+			case c == '{' || c == '[':
+				depth++
+			case c == '}' || c == ']':
+				depth--
+				if depth == 0 {
+					return i
+				}
+			}
+		}
+	}
+
+	for i, line := range lines[1:] {
+		if string(bytes.TrimSpace(line)) == "}" {
+			return i + 1
+		}
+	}
+	return -1
+}
+
+// maskFrontmatter blanks out the frontmatter bytes, keeping line breaks, so
+// that Markdown parsing ignores formats goldmark-meta does not strip while
+// byte offsets and line numbers stay the same
+func maskFrontmatter(content []byte, end int) []byte {
+	masked := bytes.Clone(content)
+	for i := range end {
+		if masked[i] != '\n' && masked[i] != '\r' {
+			masked[i] = ' '
+		}
+	}
+	return masked
+}
+
+// parseFrontmatterBlock decodes a TOML or JSON frontmatter block. Data is nil
+// when the block cannot be decoded, like YAML that does not parse.
+func parseFrontmatterBlock(block frontmatterBlock) *FrontMatter {
+	fm := &FrontMatter{Format: block.format, Content: block.source}
+	switch block.format {
+	case FormatTOML:
+		var data map[string]any
+		if _, err := toml.Decode(block.source, &data); err != nil {
+			return fm
+		}
+		fm.Data = tomlData(data).(map[string]any)
+		fm.Node = tomlNode(fm.Data, scanTOMLPositions(block.source, block.firstLine), block.firstLine)
+	case FormatJSON:
+		var data map[string]any
+		if err := json.Unmarshal([]byte(block.source), &data); err != nil {
+			return fm
+		}
+		if data == nil {
+			data = make(map[string]any)
+		}
+		fm.Data = data
+		// JSON is YAML flow syntax, so yaml.v3 provides the positions
+		fm.Node = parseFrontmatterNode(block.source, block.firstLine)
+	}
+	fm.Links = extractFrontmatterLinks(fm.Data, fm.Node)
+	return fm
+}
+
+// parseFrontmatterNode parses frontmatter source into a yaml.Node mapping
+// whose positions are document lines. It returns nil when the source is not
+// a YAML mapping.
+func parseFrontmatterNode(source string, firstLine int) *yaml.Node {
+	var doc yaml.Node
+	if err := yaml.Unmarshal([]byte(source), &doc); err != nil {
+		return nil
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil
+	}
+	root := doc.Content[0]
+	offsetNodeLines(root, firstLine-1)
+	return root
+}
+
+// offsetNodeLines shifts the line of a node and its descendants
+func offsetNodeLines(node *yaml.Node, offset int) {
+	node.Line += offset
+	for _, child := range node.Content {
+		offsetNodeLines(child, offset)
+	}
+}
+
+// Lookup finds a field by its path segments (e.g. ["metadata", "author"])
+// and returns its key and value nodes. Numeric segments index into arrays;
+// an array element is its own key. When the field does not exist, key and
+// value are nil and parent is the key node of the deepest existing ancestor
+// (nil at the top level), so a missing nested field can be reported at its
+// parent.
+func (fm *FrontMatter) Lookup(segments []string) (key, value, parent *yaml.Node) {
+	if fm == nil || fm.Node == nil {
+		return nil, nil, nil
+	}
+	current := fm.Node
+	for _, seg := range segments {
+		if current.Kind == yaml.SequenceNode {
+			i, err := strconv.Atoi(seg)
+			if err != nil || i < 0 || i >= len(current.Content) {
+				return nil, nil, parent
+			}
+			key, value = current.Content[i], current.Content[i]
+			parent = key
+			current = value
+			continue
+		}
+		if current.Kind != yaml.MappingNode {
+			return nil, nil, parent
+		}
+		var found bool
+		for i := 0; i+1 < len(current.Content); i += 2 {
+			if current.Content[i].Value == seg {
+				key, value = current.Content[i], current.Content[i+1]
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, nil, parent
+		}
+		parent = key
+		current = value
+	}
+	return key, value, parent
+}
+
+// tomlData converts arrays of tables, which TOML decodes as
+// []map[string]any, to []any like every other array
+func tomlData(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for k, elem := range val {
+			val[k] = tomlData(elem)
+		}
+		return val
+	case []map[string]any:
+		out := make([]any, len(val))
+		for i, elem := range val {
+			out[i] = tomlData(elem)
+		}
+		return out
+	case []any:
+		for i, elem := range val {
+			val[i] = tomlData(elem)
+		}
+		return val
+	}
+	return v
+}
+
+// tomlPosition is the document position of a TOML key and of its value
+type tomlPosition struct {
+	line, keyColumn, valueColumn int
+}
+
+// tomlPath joins key segments into a positions map key
+func tomlPath(segments []string) string {
+	return strings.Join(segments, "\x00")
+}
+
+// scanTOMLPositions records where each key, table, and array of tables
+// element is defined. The TOML decoder does not expose positions, so the
+// source is scanned line by line; array elements share their array's
+// position.
+func scanTOMLPositions(source string, firstLine int) map[string]tomlPosition {
+	positions := make(map[string]tomlPosition)
+	record := func(path []string, pos tomlPosition) {
+		if _, ok := positions[tomlPath(path)]; !ok {
+			positions[tomlPath(path)] = pos
+		}
+	}
+
+	lines := strings.Split(source, "\n")
+	var table []string
+	arrayTables := make(map[string]int)
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimRight(lines[i], "\r")
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" || trimmed[0] == '#' {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		lineNo := firstLine + i
+
+		switch {
+		case strings.HasPrefix(trimmed, "[["):
+			end := strings.Index(trimmed, "]]")
+			if end < 0 {
+				continue
+			}
+			path := parseTOMLKey(trimmed[2:end])
+			col := column(line, indent+2)
+			record(path, tomlPosition{lineNo, col, col})
+			index := arrayTables[tomlPath(path)]
+			arrayTables[tomlPath(path)]++
+			table = append(slices.Clone(path), strconv.Itoa(index))
+			record(table, tomlPosition{lineNo, col, col})
+		case trimmed[0] == '[':
+			end := strings.Index(trimmed, "]")
+			if end < 0 {
+				continue
+			}
+			table = parseTOMLKey(trimmed[1:end])
+			col := column(line, indent+1)
+			for j := 1; j <= len(table); j++ {
+				record(table[:j], tomlPosition{lineNo, col, col})
+			}
+		default:
+			eq := indexOutsideQuotes(line, '=')
+			if eq < 0 {
+				continue
+			}
+			path := append(slices.Clone(table), parseTOMLKey(line[:eq])...)
+			valueStart := eq + 1 + len(line[eq+1:]) - len(strings.TrimLeft(line[eq+1:], " \t"))
+			keyCol := column(line, indent)
+			for j := len(table) + 1; j < len(path); j++ {
+				record(path[:j], tomlPosition{lineNo, keyCol, keyCol})
+			}
+			record(path, tomlPosition{lineNo, keyCol, column(line, valueStart)})
+			i += tomlContinuationLines(line[valueStart:], lines[i+1:])
+		}
+	}
+	return positions
+}
+
+// column converts a byte offset in a line to a 1-based character column
+func column(line string, offset int) int {
+	return utf8.RuneCountInString(line[:min(offset, len(line))]) + 1
+}
+
+// parseTOMLKey splits a possibly dotted and quoted TOML key into segments
+func parseTOMLKey(key string) []string {
+	var segments []string
+	var current strings.Builder
+	var quote byte
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == '"' && c == '\\' && i+1 < len(key):
+			i++
+			current.WriteByte(key[i])
+		case quote != 0:
+			current.WriteByte(c)
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '.':
+			segments = append(segments, strings.TrimSpace(current.String()))
+			current.Reset()
+		case c != ' ' && c != '\t':
+			current.WriteByte(c)
+		}
+	}
+	return append(segments, strings.TrimSpace(current.String()))
+}
+
+// indexOutsideQuotes returns the index of the first c outside a quoted
+// string, or -1
+func indexOutsideQuotes(s string, c byte) int {
+	var quote byte
+	for i := 0; i < len(s); i++ {
+		switch {
+		case quote != 0 && s[i] == quote:
+			quote = 0
+		case quote == '"' && s[i] == '\\':
+			i++
+		case quote == 0 && (s[i] == '"' || s[i] == '\''):
+			quote = s[i]
+		case quote == 0 && s[i] == c:
+			return i
+		}
+	}
+	return -1
+}
+
+// tomlContinuationLines counts the lines after a value's first line that
+// belong to it: the rest of a multi-line string, array, or inline table
+func tomlContinuationLines(value string, rest []string) int {
+	for _, delim := range []string{`"""`, "'''"} {
+		if strings.HasPrefix(value, delim) {
+			if strings.Contains(value[len(delim):], delim) {
+				return 0
+			}
+			for i, line := range rest {
+				if strings.Contains(line, delim) {
+					return i + 1
+				}
+			}
+			return len(rest)
+		}
+	}
+
+	depth := tomlBracketDepth(value, 0)
+	n := 0
+	for depth > 0 && n < len(rest) {
+		depth = tomlBracketDepth(rest[n], depth)
+		n++
+	}
+	return n
+}
+
+// tomlBracketDepth updates the nesting depth of arrays and inline tables
+// with the brackets on a line, ignoring strings and comments
+func tomlBracketDepth(line string, depth int) int {
+	var quote byte
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case quote != 0 && c == quote:
+			quote = 0
+		case quote == '"' && c == '\\':
+			i++
+		case quote != 0:
+		case c == '"' || c == '\'':
+			quote = c
+		case c == '#':
+			return depth
+		case c == '[' || c == '{':
+			depth++
+		case c == ']' || c == '}':
+			depth--
+		}
+	}
+	return depth
+}
+
+// tomlNode builds a yaml.Node mapping mirroring decoded TOML data, so that
+// TOML frontmatter supports Lookup like YAML. Keys are ordered by position.
+func tomlNode(data map[string]any, positions map[string]tomlPosition, firstLine int) *yaml.Node {
+	return tomlValueNode(data, nil, positions, tomlPosition{firstLine, 1, 1})
+}
+
+func tomlValueNode(value any, path []string, positions map[string]tomlPosition, pos tomlPosition) *yaml.Node {
+	node := &yaml.Node{Line: pos.line, Column: pos.valueColumn}
+	switch val := value.(type) {
+	case map[string]any:
+		node.Kind, node.Tag = yaml.MappingNode, "!!map"
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		keyPos := func(k string) tomlPosition {
+			if p, ok := positions[tomlPath(append(slices.Clone(path), k))]; ok {
+				return p
+			}
+			return pos
+		}
+		slices.SortFunc(keys, func(a, b string) int {
+			pa, pb := keyPos(a), keyPos(b)
+			if pa.line != pb.line {
+				return pa.line - pb.line
+			}
+			if pa.keyColumn != pb.keyColumn {
+				return pa.keyColumn - pb.keyColumn
+			}
+			return strings.Compare(a, b)
+		})
+		for _, k := range keys {
+			p := keyPos(k)
+			node.Content = append(node.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: k, Line: p.line, Column: p.keyColumn},
+				tomlValueNode(val[k], append(slices.Clone(path), k), positions, p))
+		}
+	case []any:
+		node.Kind, node.Tag = yaml.SequenceNode, "!!seq"
+		for i, elem := range val {
+			p, ok := positions[tomlPath(append(slices.Clone(path), strconv.Itoa(i)))]
+			if !ok {
+				p = tomlPosition{pos.line, pos.valueColumn, pos.valueColumn}
+			}
+			node.Content = append(node.Content, tomlValueNode(elem, append(slices.Clone(path), strconv.Itoa(i)), positions, p))
+		}
+	default:
+		node.Kind = yaml.ScalarNode
+		switch v := val.(type) {
+		case string:
+			node.Tag, node.Value = "!!str", v
+		case bool:
+			node.Tag, node.Value = "!!bool", strconv.FormatBool(v)
+		case int64:
+			node.Tag, node.Value = "!!int", strconv.FormatInt(v, 10)
+		case float64:
+			node.Tag, node.Value = "!!float", strconv.FormatFloat(v, 'g', -1, 64)
+		case time.Time:
+			node.Tag, node.Value = "!!timestamp", v.Format(time.RFC3339)
+		default:
+			node.Tag, node.Value = "!!str", fmt.Sprint(v)
+		}
+	}
+	return node
+}
@@ -54,3 +54,19 @@ func TestParagraphsEmptySection(t *testing.T) {
 		}
 	}
 }
+
+func TestParagraphText(t *testing.T) {
+	src := []byte("# Title\n\nA *short* guide to `mdschema`\nand [its rules](rules.md).\n")
+	doc, err := New().Parse("test.md", src)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	paragraphs := doc.GetSections()[0].Paragraphs
+	if len(paragraphs) != 1 {
+		t.Fatalf("len(Paragraphs) = %d, want 1", len(paragraphs))
+	}
+	if want := "A short guide to mdschema and its rules."; paragraphs[0].Text != want {
+		t.Errorf("Text = %q, want %q", paragraphs[0].Text, want)
+	}
+}
@@ -24,6 +24,7 @@ func New() *Parser {
 		md: goldmark.New(
 			goldmark.WithExtensions(
 				extension.Table,
+				extension.TaskList,
 				meta.Meta,
 			),
 			goldmark.WithParserOptions(
@@ -47,17 +48,30 @@ func (p *Parser) ParseFile(path string) (*Document, error) {
 func (p *Parser) Parse(path string, content []byte) (*Document, error) {
 	// Create a parser context to capture metadata
 	ctx := parser.NewContext()
-	reader := text.NewReader(content)
+	block, hasBlock := detectFrontmatter(content)
+	source := content
+	if hasBlock && block.format != FormatYAML {
+		// goldmark-meta only strips YAML; hide other formats from the Markdown parser
+		source = maskFrontmatter(content, block.end)
+	}
+	reader := text.NewReader(source)
 	node := p.md.Parser().Parse(reader, parser.WithContext(ctx))
 
-	// Extract frontmatter from goldmark-meta
+	// Extract frontmatter from goldmark-meta, or decode TOML and JSON directly
 	var frontMatter *FrontMatter
 	if metaData := meta.Get(ctx); metaData != nil {
 		frontMatter = &FrontMatter{
-			Format: "yaml",
+			Format: FormatYAML,
 			Data:   metaData,
-			Links:  extractFrontmatterLinks(metaData),
 		}
+		// Keep the YAML node tree so violations can point at keys and values
+		if hasBlock && block.format == FormatYAML {
+			frontMatter.Content = block.source
+			frontMatter.Node = parseFrontmatterNode(block.source, block.firstLine)
+		}
+		frontMatter.Links = extractFrontmatterLinks(metaData, frontMatter.Node)
+	} else if hasBlock && block.format != FormatYAML {
+		frontMatter = parseFrontmatterBlock(block)
 	}
 
 	// Temporary collections for building the tree
@@ -1,8 +1,10 @@
 package parser
 
 import (
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 )
 
@@ -112,6 +114,57 @@ func TestParseCodeBlocks(t *testing.T) {
 	if codeBlocks[1].Lang != "bash" {
 		t.Errorf("codeBlocks[1].Lang = %q, want %q", codeBlocks[1].Lang, "bash")
 	}
+	if codeBlocks[0].Content != "func main() {}\n" {
+		t.Errorf("codeBlocks[0].Content = %q, want %q", codeBlocks[0].Content, "func main() {}\n")
+	}
+	if codeBlocks[1].Line != 8 {
+		t.Errorf("codeBlocks[1].Line = %d, want 8", codeBlocks[1].Line)
+	}
+}
+
+func TestParseInfoString(t *testing.T) {
+	tests := []struct {
+		info      string
+		wantLang  string
+		wantAttrs map[string]string
+	}{
+		{"", "", nil},
+		{"go", "go", nil},
+		{`go title="main.go" {3-5}`, "go", map[string]string{"title": "main.go"}},
+		{`js title='my file.js' showLineNumbers`, "js", map[string]string{"title": "my file.js", "showLineNumbers": ""}},
+		{"python{1, 4-6} linenos=table", "python", map[string]string{"linenos": "table"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.info, func(t *testing.T) {
+			lang, attrs := parseInfoString(tt.info)
+			if lang != tt.wantLang {
+				t.Errorf("lang = %q, want %q", lang, tt.wantLang)
+			}
+			if !maps.Equal(attrs, tt.wantAttrs) {
+				t.Errorf("attrs = %v, want %v", attrs, tt.wantAttrs)
+			}
+		})
+	}
+}
+
+func TestParseEmptyCodeBlock(t *testing.T) {
+	doc, err := New().Parse("test.md", []byte("# A\n\n## B\n\n```\n```\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	// The block belongs to section B even though it has no body lines
+	sections := doc.GetSections()
+	if len(sections) != 2 {
+		t.Fatalf("GetSections() returned %d sections, want 2", len(sections))
+	}
+	if len(sections[1].CodeBlocks) != 1 {
+		t.Fatalf("section B has %d code blocks, want 1", len(sections[1].CodeBlocks))
+	}
+	if block := sections[1].CodeBlocks[0]; block.Line != 5 || block.Content != "" {
+		t.Errorf("block Line = %d, Content = %q, want 5 and empty", block.Line, block.Content)
+	}
 }
 
 func TestParseNestedSections(t *testing.T) {
@@ -214,6 +267,73 @@ func TestParseTables(t *testing.T) {
 	if len(tables[0].Headers) != 2 {
 		t.Errorf("table has %d headers, want 2", len(tables[0].Headers))
 	}
+	if len(tables[0].Rows) != 1 {
+		t.Fatalf("table has %d rows, want 1", len(tables[0].Rows))
+	}
+	if row := tables[0].Rows[0]; row.Line != 5 || !slices.Equal(row.Cells, []string{"Cell 1", "Cell 2"}) {
+		t.Errorf("row = %d %q, want 5 [Cell 1 Cell 2]", row.Line, row.Cells)
+	}
+}
+
+func TestParseTablePositions(t *testing.T) {
+	// The header row has no text, so positions must come from the table
+	// rows themselves rather than their first text node
+	content := []byte("# Table Test\n\n|  |  |\n| - | - |\n| a | b |\n| c | d |\n")
+	doc, err := New().Parse("test.md", content)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	table := doc.GetSections()[0].Tables[0]
+	if table.Line != 3 {
+		t.Errorf("table line = %d, want 3", table.Line)
+	}
+	for i, want := range []int{5, 6} {
+		if got := table.Rows[i].Line; got != want {
+			t.Errorf("row %d line = %d, want %d", i, got, want)
+		}
+	}
+}
+
+func TestParseListItems(t *testing.T) {
+	content := []byte(`# Checklist
+
+- [x] Tag release
+- [ ] Publish notes
+  on the blog
+  - nested detail
+`)
+	doc, err := New().Parse("test.md", content)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	lists := doc.GetSections()[0].Lists
+	if len(lists) != 2 {
+		t.Fatalf("section has %d lists, want 2", len(lists))
+	}
+
+	top, nested := lists[0], lists[1]
+	if top.Level != 1 || nested.Level != 2 {
+		t.Errorf("list levels = %d, %d, want 1, 2", top.Level, nested.Level)
+	}
+	if len(top.Items) != 2 {
+		t.Fatalf("top-level list has %d items, want 2", len(top.Items))
+	}
+
+	want := []ListItem{
+		{Text: "Tag release", Level: 1, IsTask: true, Checked: true, Line: 3, Column: 1},
+		{Text: "Publish notes on the blog", Level: 1, IsTask: true, Checked: false, Line: 4, Column: 1},
+	}
+	for i, item := range top.Items {
+		if *item != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, *item, want[i])
+		}
+	}
+
+	if len(nested.Items) != 1 || nested.Items[0].Text != "nested detail" || nested.Items[0].IsTask {
+		t.Errorf("nested items = %+v, want one non-task item 'nested detail'", nested.Items)
+	}
 }
 
 func TestParseFile(t *testing.T) {
@@ -400,22 +520,35 @@ func TestHeadingWithInlineFormatting(t *testing.T) {
 	}
 
 	tests := []struct {
-		index    int
-		wantText string
-		wantSlug string
+		index        int
+		wantText     string
+		wantSlug     string
+		wantProse    string
+		wantEmphasis bool
+		wantCode     bool
 	}{
-		{0, "Bold Heading", "bold-heading"},
-		{1, "Heading with code", "heading-with-code"},
-		{2, "Heading with emphasis", "heading-with-emphasis"},
-		{3, "Link to something here", "link-to-something-here"},
+		{0, "Bold Heading", "bold-heading", "Bold Heading", true, false},
+		{1, "Heading with code", "heading-with-code", "Heading with", false, true},
+		{2, "Heading with emphasis", "heading-with-emphasis", "Heading with emphasis", true, false},
+		{3, "Link to something here", "link-to-something-here", "Link to something here", false, false},
 	}
 
 	for _, tc := range tests {
-		if sections[tc.index].Heading.Text != tc.wantText {
-			t.Errorf("sections[%d].Heading.Text = %q, want %q", tc.index, sections[tc.index].Heading.Text, tc.wantText)
+		h := sections[tc.index].Heading
+		if h.Text != tc.wantText {
+			t.Errorf("sections[%d].Heading.Text = %q, want %q", tc.index, h.Text, tc.wantText)
+		}
+		if h.Slug != tc.wantSlug {
+			t.Errorf("sections[%d].Heading.Slug = %q, want %q", tc.index, h.Slug, tc.wantSlug)
+		}
+		if h.Prose != tc.wantProse {
+			t.Errorf("sections[%d].Heading.Prose = %q, want %q", tc.index, h.Prose, tc.wantProse)
 		}
-		if sections[tc.index].Heading.Slug != tc.wantSlug {
-			t.Errorf("sections[%d].Heading.Slug = %q, want %q", tc.index, sections[tc.index].Heading.Slug, tc.wantSlug)
+		if h.HasEmphasis != tc.wantEmphasis {
+			t.Errorf("sections[%d].Heading.HasEmphasis = %v, want %v", tc.index, h.HasEmphasis, tc.wantEmphasis)
+		}
+		if h.HasCode != tc.wantCode {
+			t.Errorf("sections[%d].Heading.HasCode = %v, want %v", tc.index, h.HasCode, tc.wantCode)
 		}
 	}
 }
@@ -491,6 +624,143 @@ func main() {}
 	}
 }
 
+func TestFrontMatterLookup(t *testing.T) {
+	content := []byte("---\ntitle: Test\nhomepage: https://example.com\nmetadata:\n  author: me\n---\n\n# Heading\n")
+	doc, err := New().Parse("test.md", content)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	fm := doc.FrontMatter
+
+	if fm.Content != "title: Test\nhomepage: https://example.com\nmetadata:\n  author: me\n" {
+		t.Errorf("Content = %q", fm.Content)
+	}
+
+	key, value, _ := fm.Lookup([]string{"metadata", "author"})
+	if key == nil || key.Line != 5 || key.Column != 3 || value.Value != "me" {
+		t.Errorf("Lookup(metadata.author) = %v, %v, want key at 5:3 with value me", key, value)
+	}
+
+	key, _, parent := fm.Lookup([]string{"metadata", "missing"})
+	if key != nil || parent == nil || parent.Line != 4 {
+		t.Errorf("Lookup(metadata.missing) = %v, parent %v, want nil with parent at line 4", key, parent)
+	}
+
+	if len(fm.Links) != 1 || fm.Links[0].Line != 3 || fm.Links[0].Column != 11 {
+		t.Errorf("Links = %v, want one link at 3:11", fm.Links)
+	}
+}
+
+func TestParseFrontmatterFormats(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantFormat string
+		path       []string
+		wantValue  string
+		wantLine   int // Line and column of the value
+		wantCol    int
+	}{
+		{
+			name:       "toml",
+			content:    "+++\n# A comment\ntitle = \"Hello\"\ntags = [\"go\", \"toml\"]\n\n[params]\n  homepage = \"https://example.com\"\n+++\n\n# Heading\n",
+			wantFormat: FormatTOML,
+			path:       []string{"params", "homepage"},
+			wantValue:  "https://example.com",
+			wantLine:   7,
+			wantCol:    14,
+		},
+		{
+			name:       "toml array of tables",
+			content:    "+++\n[[authors]]\nname = \"a\"\n[[authors]]\nname = \"b\"\n+++\n\n# Heading\n",
+			wantFormat: FormatTOML,
+			path:       []string{"authors", "1", "name"},
+			wantValue:  "b",
+			wantLine:   5,
+			wantCol:    8,
+		},
+		{
+			name:       "json delimited",
+			content:    ";;;\n{\n  \"title\": \"Hello\",\n  \"homepage\": \"https://example.com\"\n}\n;;;\n\n# Heading\n",
+			wantFormat: FormatJSON,
+			path:       []string{"homepage"},
+			wantValue:  "https://example.com",
+			wantLine:   4,
+			wantCol:    15,
+		},
+		{
+			name:       "json object",
+			content:    "{\n  \"title\": \"Hello\",\n  \"homepage\": \"https://example.com\"\n}\n\n# Heading\n",
+			wantFormat: FormatJSON,
+			path:       []string{"homepage"},
+			wantValue:  "https://example.com",
+			wantLine:   3,
+			wantCol:    15,
+		},
+		{
+			name:       "json object with nested object",
+			content:    "{\n  \"title\": \"x }\",\n  \"author\": {\n    \"name\": \"y\"\n  }\n}\n\n# Heading\n",
+			wantFormat: FormatJSON,
+			path:       []string{"author", "name"},
+			wantValue:  "y",
+			wantLine:   4,
+			wantCol:    13,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := New().Parse("test.md", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			fm := doc.FrontMatter
+			if fm == nil || fm.Data == nil {
+				t.Fatalf("FrontMatter = %+v, want parsed data", fm)
+			}
+			if fm.Format != tt.wantFormat {
+				t.Errorf("Format = %q, want %q", fm.Format, tt.wantFormat)
+			}
+
+			_, value, _ := fm.Lookup(tt.path)
+			if value == nil || value.Value != tt.wantValue || value.Line != tt.wantLine || value.Column != tt.wantCol {
+				t.Errorf("Lookup(%v) = %+v, want %q at %d:%d", tt.path, value, tt.wantValue, tt.wantLine, tt.wantCol)
+			}
+
+			// The block is not parsed as Markdown, so the only heading is the real one
+			headings := doc.GetSections()
+			if len(headings) != 1 || headings[0].Heading.Text != "Heading" {
+				t.Errorf("sections = %d, want only '# Heading'", len(headings))
+			}
+			if len(doc.Root.Paragraphs) != 0 {
+				t.Errorf("frontmatter leaked into the body as %d paragraph(s)", len(doc.Root.Paragraphs))
+			}
+		})
+	}
+}
+
+func TestParseFrontmatterLinksTOML(t *testing.T) {
+	content := []byte("+++\ntitle = \"Hello\"\nhomepage = \"https://example.com\"\n+++\n\n# Heading\n")
+	doc, err := New().Parse("test.md", content)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	links := doc.FrontMatter.Links
+	if len(links) != 1 || links[0].URL != "https://example.com" || links[0].Line != 3 || links[0].Column != 12 {
+		t.Errorf("Links = %v, want one link at 3:12", links)
+	}
+}
+
+func TestParseInvalidTOMLFrontmatter(t *testing.T) {
+	doc, err := New().Parse("test.md", []byte("+++\ntitle = \n+++\n\n# Heading\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	if doc.FrontMatter == nil || doc.FrontMatter.Format != FormatTOML || doc.FrontMatter.Data != nil {
+		t.Errorf("FrontMatter = %+v, want TOML with nil Data", doc.FrontMatter)
+	}
+}
+
 // TestFrontmatterLineOffset verifies that line numbers are correct when frontmatter is present
 func TestFrontmatterLineOffset(t *testing.T) {
 	p := New()
@@ -1,6 +1,11 @@
 package parser
 
-import "github.com/yuin/goldmark/ast"
+import (
+	"slices"
+
+	"github.com/yuin/goldmark/ast"
+	"gopkg.in/yaml.v3"
+)
 
 // Document represents a parsed Markdown document with hierarchical structure
 type Document struct {
@@ -25,6 +30,10 @@ type Heading struct {
 	Line   int
 	Column int
 	Slug   string
+
+	Prose       string // Text with inline code spans removed, used for style checks
+	HasEmphasis bool   // Heading contains *emphasis* or **strong** text
+	HasCode     bool   // Heading contains an inline code span
 }
 
 // Section represents a section of content under a heading
@@ -54,11 +63,24 @@ func (s *Section) collectSections(sections *[]*Section) {
 	}
 }
 
+// AllCodeBlocks returns the code blocks of the section and all of its
+// subsections, in document order
+func (s *Section) AllCodeBlocks() []*CodeBlock {
+	blocks := slices.Clone(s.CodeBlocks)
+	for _, child := range s.Children {
+		blocks = append(blocks, child.AllCodeBlocks()...)
+	}
+	return blocks
+}
+
 // CodeBlock represents a code block
 type CodeBlock struct {
-	Lang   string
-	Line   int
-	Column int
+	Lang       string            // Language, the first word of the info string
+	Info       string            // Full info string after the opening fence
+	Attributes map[string]string // Info string attributes; bare words have an empty value
+	Content    string            // Body of the block without the fences
+	Line       int               // Line of the first body line (the fence for empty blocks)
+	Column     int
 }
 
 // Link represents a link in the document
@@ -73,12 +95,25 @@ type Link struct {
 // List represents a list in the document
 type List struct {
 	IsOrdered bool
+	Level     int         // Nesting level, 1 for a top-level list
+	Items     []*ListItem // Direct items; nested lists are recorded separately
 	Line      int
 	Column    int
 }
 
+// ListItem represents an item of a list
+type ListItem struct {
+	Text    string // Item text, excluding nested lists
+	Level   int    // Nesting level of the containing list
+	IsTask  bool   // Item starts with a GFM task checkbox
+	Checked bool   // Task checkbox is checked
+	Line    int
+	Column  int
+}
+
 // Paragraph represents a top-level prose paragraph (direct child of the document)
 type Paragraph struct {
+	Text   string // Plain text, with line breaks as spaces
 	Line   int
 	Column int
 }
@@ -86,10 +121,17 @@ type Paragraph struct {
 // Table represents a table in the document
 type Table struct {
 	Headers []string
+	Rows    []*TableRow // Body rows, excluding the header
 	Line    int
 	Column  int
 }
 
+// TableRow represents a body row of a table
+type TableRow struct {
+	Cells []string
+	Line  int
+}
+
 // Image represents an image in the document
 type Image struct {
 	URL    string
@@ -100,9 +142,10 @@ type Image struct {
 
 // FrontMatter represents document front matter
 type FrontMatter struct {
-	Format  string // "yaml" or "toml"
-	Content string
+	Format  string // FormatYAML, FormatTOML, or FormatJSON
+	Content string // Source between the delimiters
 	Data    map[string]any
+	Node    *yaml.Node // Mapping node with document positions; nil if unavailable
 	Links   []*Link
 }
 
@@ -19,6 +19,10 @@ func getPosition(node ast.Node, content []byte) (line, col int) {
 		if n.Lines().Len() > 0 {
 			return calculateLineColumn(content, n.Lines().At(0).Start)
 		}
+		// Empty blocks have no body lines; use the opening fence
+		if n.Pos() >= 0 {
+			return calculateLineColumn(content, n.Pos())
+		}
 	case *ast.Text:
 		return calculateLineColumn(content, n.Segment.Start)
 	case *ast.List:
@@ -29,6 +33,14 @@ func getPosition(node ast.Node, content []byte) (line, col int) {
 		if n.Lines().Len() > 0 {
 			return calculateLineColumn(content, n.Lines().At(0).Start)
 		}
+		// Tables keep no lines of their own; the parser records where they start
+		if n.Pos() >= 0 {
+			return calculateLineColumn(content, n.Pos())
+		}
+	case *east.TableRow:
+		if n.Pos() >= 0 {
+			return calculateLineColumn(content, n.Pos())
+		}
 	case *ast.Paragraph:
 		if n.Lines().Len() > 0 {
 			return calculateLineColumn(content, n.Lines().At(0).Start)
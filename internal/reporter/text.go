@@ -79,19 +79,29 @@ func (r *TextReporter) formatViolation(v rules.Violation) string {
 	}
 
 	position := fmt.Sprintf("%d:%d", v.Line, v.Column)
-	return fmt.Sprintf("  %s %s %s %s",
+	line := fmt.Sprintf("  %s %s %s %s",
 		colorFunc(icon),
 		r.formatDim(position),
-		r.formatRule(v.Rule),
+		r.formatRule(v),
 		v.Message)
+	if v.Fix != nil {
+		line += "\n    " + r.formatDim(fmt.Sprintf("fix: replace with '%s'", v.Fix.Replacement))
+	}
+	if v.HelpURL != "" {
+		line += "\n    " + r.formatDim("see "+v.HelpURL)
+	}
+	return line
 }
 
 func (r *TextReporter) formatFile(path string) string {
 	return r.formatBold(path)
 }
 
-func (r *TextReporter) formatRule(rule string) string {
-	return r.formatDim(fmt.Sprintf("[%s]", rule))
+func (r *TextReporter) formatRule(v rules.Violation) string {
+	if v.Code != "" {
+		return r.formatDim(fmt.Sprintf("[%s %s]", v.Rule, v.Code))
+	}
+	return r.formatDim(fmt.Sprintf("[%s]", v.Rule))
 }
 
 // Color formatting functions
@@ -1,9 +1,24 @@
 package rules
 
 import (
+	"cmp"
+	"encoding/json"
+	"errors"
 	"fmt"
+	goparser "go/parser"
+	"go/scanner"
+	"go/token"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
@@ -25,18 +40,20 @@ func (r *CodeBlockRule) Name() string {
 }
 
 // validateCodeBlockRequirement validates a specific code block requirement for a node
-func (r *CodeBlockRule) validateCodeBlockRequirement(n *vast.Node, requirement schema.CodeBlockRule) []Violation {
+func (r *CodeBlockRule) validateCodeBlockRequirement(n *vast.Node, requirement schema.CodeBlockRule, cfg *schema.CodeBlocksConfig) []Violation {
 	violations := make([]Violation, 0)
 
 	// Count matching code blocks directly from the node
 	count := 0
 	for _, block := range n.CodeBlocks() {
-		if requirement.Lang == "" || block.Lang == requirement.Lang {
+		if requirement.Lang == "" || cfg.SameLang(block.Lang, requirement.Lang) {
 			count++
 		}
 	}
 
 	line, col := n.Location()
+	opts := sectionReportOptions(requirement.Severity, requirement.Message, requirement.HelpURL, n.Element)
+	data := MessageData{Heading: n.HeadingText(), Found: count, Expected: requirement.Lang, Min: requirement.Min, Max: requirement.Max}
 
 	// Check minimum requirement
 	if requirement.Min > 0 && count < requirement.Min {
@@ -46,7 +63,7 @@ func (r *CodeBlockRule) validateCodeBlockRequirement(n *vast.Node, requirement s
 				n.HeadingText(), requirement.Min, requirement.Lang, count)
 		}
 
-		violations = append(violations, NewViolation(r.Name(), message, line, col))
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeTooFewCodeBlocks), data))
 	}
 
 	// Check maximum requirement
@@ -57,12 +74,291 @@ func (r *CodeBlockRule) validateCodeBlockRequirement(n *vast.Node, requirement s
 				n.HeadingText(), requirement.Lang, requirement.Max, count)
 		}
 
-		violations = append(violations, NewViolation(r.Name(), message, line, col))
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeTooManyCodeBlocks), data))
+	}
+
+	for _, block := range n.CodeBlocks() {
+		if requirement.Lang == "" || cfg.SameLang(block.Lang, requirement.Lang) {
+			violations = append(violations, r.validateBlock(n, block, requirement, cfg, opts)...)
+		}
 	}
 
 	return violations
 }
 
+// validateBlock runs the per-block checks of a requirement. Violations are
+// reported at the block rather than the section heading.
+func (r *CodeBlockRule) validateBlock(n *vast.Node, block *parser.CodeBlock, requirement schema.CodeBlockRule, cfg *schema.CodeBlocksConfig, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	heading := n.HeadingText()
+
+	if requirement.RequireLang && requirement.Lang == "" && block.Lang == "" {
+		message := fmt.Sprintf("Code block in section '%s' has no language identifier", heading)
+		data := MessageData{Heading: heading}
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+			WithCode(CodeMissingCodeLang), data))
+	}
+
+	for _, attr := range requirement.RequiredAttributes {
+		if _, ok := block.Attributes[attr]; !ok {
+			message := fmt.Sprintf("Code block in section '%s' is missing attribute '%s'", heading, attr)
+			data := MessageData{Heading: heading, Expected: attr}
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+				WithCode(CodeMissingCodeAttribute), data))
+		}
+	}
+
+	if len(requirement.AllowedAttributes) > 0 {
+		for _, attr := range slices.Sorted(maps.Keys(block.Attributes)) {
+			if slices.Contains(requirement.AllowedAttributes, attr) {
+				continue
+			}
+			message := fmt.Sprintf("Code block in section '%s' has unexpected attribute '%s' (allowed: %s)",
+				heading, attr, strings.Join(requirement.AllowedAttributes, ", "))
+			data := MessageData{Heading: heading, Found: attr, Expected: requirement.AllowedAttributes}
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+				WithCode(CodeUnexpectedCodeAttribute), data))
+		}
+	}
+
+	lines := lineCount(block.Content)
+	data := MessageData{Heading: heading, Found: lines, Expected: block.Lang, Min: requirement.MinLines, Max: requirement.MaxLines}
+	if requirement.MinLines > 0 && lines < requirement.MinLines {
+		message := fmt.Sprintf("Code block in section '%s' has %d lines (min %d)", heading, lines, requirement.MinLines)
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+			WithCode(CodeCodeBlockTooShort), data))
+	}
+	if requirement.MaxLines > 0 && lines > requirement.MaxLines {
+		message := fmt.Sprintf("Code block in section '%s' has %d lines (max %d)", heading, lines, requirement.MaxLines)
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+			WithCode(CodeCodeBlockTooLong), data))
+	}
+
+	for _, pattern := range requirement.Contains {
+		if indexTextPattern(block.Content, pattern.Literal, pattern.Pattern) >= 0 {
+			continue
+		}
+		patternStr := cmp.Or(pattern.Literal, pattern.Pattern)
+		message := fmt.Sprintf("Code block in section '%s' does not contain '%s'", heading, patternStr)
+		data := MessageData{Heading: heading, Expected: patternStr}
+		popts := codePatternOptions(pattern.Severity, pattern.Message, pattern.HelpURL, requirement, n)
+		violations = append(violations, popts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+			WithCode(CodeMissingCodeText), data))
+	}
+
+	for _, pattern := range requirement.NotContains {
+		idx := indexTextPattern(block.Content, pattern.Literal, pattern.Pattern)
+		if idx < 0 {
+			continue
+		}
+		patternStr := cmp.Or(pattern.Literal, pattern.Pattern)
+		message := fmt.Sprintf("Code block in section '%s' contains forbidden text '%s'", heading, patternStr)
+		data := MessageData{Heading: heading, Expected: patternStr}
+		popts := codePatternOptions(pattern.Severity, pattern.Message, pattern.HelpURL, requirement, n)
+		line, col := offsetPosition(block.Content, idx)
+		violations = append(violations, popts.apply(NewViolation(r.Name(), message, block.Line+line-1, block.Column+col-1).
+			WithCode(CodeForbiddenCodeText), data))
+	}
+
+	if requirement.ValidSyntax {
+		if syntaxErr := checkSyntax(cfg.Canonical(block.Lang), block.Content); syntaxErr != nil {
+			// Body line 1 is the block's own line
+			line := block.Line + syntaxErr.line - 1
+			col := block.Column
+			if syntaxErr.col > 0 {
+				col += syntaxErr.col - 1
+			}
+			message := fmt.Sprintf("Invalid %s in code block in section '%s': %s", block.Lang, heading, syntaxErr.msg)
+			data := MessageData{Heading: heading, Found: syntaxErr.msg, Expected: block.Lang}
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).
+				WithCode(CodeInvalidCodeSyntax), data))
+		}
+	}
+
+	return violations
+}
+
+// codePatternOptions builds report options for a contains/not_contains
+// pattern, falling back to the code block requirement's settings
+func codePatternOptions(severity, message, helpURL string, requirement schema.CodeBlockRule, n *vast.Node) reportOptions {
+	return sectionReportOptions(
+		cmp.Or(severity, requirement.Severity),
+		cmp.Or(message, requirement.Message),
+		cmp.Or(helpURL, requirement.HelpURL),
+		n.Element,
+	)
+}
+
+// indexTextPattern returns the offset of the first match of a literal
+// (substring) or regex pattern in content, or -1 if there is none
+func indexTextPattern(content, literal, pattern string) int {
+	if literal != "" {
+		return strings.Index(content, literal)
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		// If regex compilation fails, fall back to substring match
+		return strings.Index(content, pattern)
+	}
+	if loc := re.FindStringIndex(content); loc != nil {
+		return loc[0]
+	}
+	return -1
+}
+
+// lineCount returns the number of lines in a code block body
+func lineCount(content string) int {
+	if content == "" {
+		return 0
+	}
+	return strings.Count(strings.TrimSuffix(content, "\n"), "\n") + 1
+}
+
+// syntaxError is a parse error positioned relative to the code block body
+type syntaxError struct {
+	line int // 1-based line within the body
+	col  int // 1-based column, or 0 when unknown
+	msg  string
+}
+
+// checkSyntax parses content as lang. Languages without a parser are not checked.
+func checkSyntax(lang, content string) *syntaxError {
+	var err *syntaxError
+	switch strings.ToLower(lang) {
+	case "json":
+		err = checkJSON(content)
+	case "yaml", "yml":
+		err = checkYAML(content)
+	case "toml":
+		err = checkTOML(content)
+	case "go", "golang":
+		err = checkGo(content)
+	}
+	if err != nil {
+		// Errors at end of input may point past the last body line
+		lastLine := max(1, lineCount(content))
+		if err.line > lastLine {
+			err.line, err.col = lastLine, 0
+		}
+	}
+	return err
+}
+
+func checkJSON(content string) *syntaxError {
+	dec := json.NewDecoder(strings.NewReader(content))
+	var v any
+	err := dec.Decode(&v)
+	if err == nil {
+		// A second value after the first is also invalid
+		if _, tokErr := dec.Token(); tokErr != io.EOF {
+			return offsetError(content, int(dec.InputOffset()), "unexpected data after top-level value")
+		}
+		return nil
+	}
+
+	var se *json.SyntaxError
+	if errors.As(err, &se) {
+		// Offset points just past the offending byte
+		return offsetError(content, int(se.Offset)-1, se.Error())
+	}
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return offsetError(content, len(content), "unexpected end of JSON input")
+	}
+	return &syntaxError{line: 1, msg: err.Error()}
+}
+
+// offsetError positions a syntax error at a byte offset in content
+func offsetError(content string, offset int, msg string) *syntaxError {
+	line, col := offsetPosition(content, offset)
+	return &syntaxError{line: line, col: col, msg: msg}
+}
+
+// offsetPosition converts a byte offset in content to a 1-based line and column
+func offsetPosition(content string, offset int) (line, col int) {
+	offset = max(0, min(offset, len(content)))
+	before := content[:offset]
+	return strings.Count(before, "\n") + 1, offset - strings.LastIndex(before, "\n")
+}
+
+var yamlLineErr = regexp.MustCompile(`^yaml: line (\d+): (.*)$`)
+
+func checkYAML(content string) *syntaxError {
+	dec := yaml.NewDecoder(strings.NewReader(content))
+	for {
+		var v any
+		err := dec.Decode(&v)
+		if err == nil {
+			continue
+		}
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		msg := err.Error()
+		if m := yamlLineErr.FindStringSubmatch(msg); m != nil {
+			line, _ := strconv.Atoi(m[1])
+			return &syntaxError{line: line, msg: m[2]}
+		}
+		return &syntaxError{line: 1, msg: strings.TrimPrefix(msg, "yaml: ")}
+	}
+}
+
+func checkTOML(content string) *syntaxError {
+	var v map[string]any
+	_, err := toml.Decode(content, &v)
+	if err == nil {
+		return nil
+	}
+	var pe toml.ParseError
+	if errors.As(err, &pe) {
+		return &syntaxError{line: pe.Position.Line, col: pe.Position.Col, msg: pe.Message}
+	}
+	return &syntaxError{line: 1, msg: err.Error()}
+}
+
+// checkGo parses a Go snippet. Snippets may be a whole file, top-level
+// declarations without a package clause, or bare statements.
+func checkGo(content string) *syntaxError {
+	fset := token.NewFileSet()
+	_, err := goparser.ParseFile(fset, "", content, goparser.SkipObjectResolution)
+	if err == nil {
+		return nil
+	}
+	if first := firstGoError(err, 0); first == nil || !strings.Contains(first.msg, "expected 'package'") {
+		return first
+	}
+
+	// Declarations without a package clause
+	_, err = goparser.ParseFile(fset, "", "package p\n"+content, goparser.SkipObjectResolution)
+	if err == nil {
+		return nil
+	}
+	declErr := firstGoError(err, 1)
+
+	// Statements, as in a function body
+	_, err = goparser.ParseFile(fset, "", "package p\nfunc _() {\n"+content+"\n}", goparser.SkipObjectResolution)
+	if err == nil {
+		return nil
+	}
+	stmtErr := firstGoError(err, 2)
+
+	// A snippet that does not start with a declaration is treated as statements
+	if declErr != nil && declErr.line == 1 && strings.Contains(declErr.msg, "expected declaration") {
+		return stmtErr
+	}
+	return declErr
+}
+
+// firstGoError returns the first error in a go/parser error list, with its
+// line shifted back by the number of wrapper lines
+func firstGoError(err error, wrapperLines int) *syntaxError {
+	var list scanner.ErrorList
+	if !errors.As(err, &list) || len(list) == 0 {
+		return &syntaxError{line: 1, msg: err.Error()}
+	}
+	pos := list[0].Pos
+	return &syntaxError{line: max(1, pos.Line-wrapperLines), col: pos.Column, msg: list[0].Msg}
+}
+
 // ValidateWithContext validates using VAST (validation-ready AST)
 func (r *CodeBlockRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	violations := make([]Violation, 0)
@@ -71,12 +367,43 @@ func (r *CodeBlockRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	ctx.Tree.WalkBound(func(n *vast.Node) bool {
 		if n.Element.SectionRules != nil && len(n.Element.CodeBlocks) > 0 {
 			for _, requirement := range n.Element.CodeBlocks {
-				violations = append(violations, r.validateCodeBlockRequirement(n, requirement)...)
+				violations = append(violations, r.validateCodeBlockRequirement(n, requirement, ctx.Schema.CodeBlocks)...)
 			}
 		}
 		return true
 	})
 
+	violations = append(violations, r.validateLanguages(ctx)...)
+
+	return violations
+}
+
+// validateLanguages flags fences anywhere in the document whose language is
+// not in the global allowed_languages list. Unlabelled fences are skipped.
+func (r *CodeBlockRule) validateLanguages(ctx *vast.Context) []Violation {
+	violations := make([]Violation, 0)
+	cfg := ctx.Schema.CodeBlocks
+	if cfg == nil || len(cfg.AllowedLanguages) == 0 {
+		return violations
+	}
+
+	opts := reportOptions{
+		severity: severityFromSchema(cfg.Severity),
+		message:  cfg.Message,
+		helpURL:  cfg.HelpURL,
+	}
+	for _, block := range ctx.Tree.Document.Root.AllCodeBlocks() {
+		if block.Lang == "" || slices.ContainsFunc(cfg.AllowedLanguages, func(lang string) bool {
+			return cfg.SameLang(block.Lang, lang)
+		}) {
+			continue
+		}
+		message := fmt.Sprintf("Code block language '%s' is not allowed (allowed: %s)",
+			block.Lang, strings.Join(cfg.AllowedLanguages, ", "))
+		data := MessageData{Found: block.Lang, Expected: cfg.AllowedLanguages}
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, block.Line, block.Column).
+			WithCode(CodeUnknownCodeLanguage), data))
+	}
 	return violations
 }
 
@@ -133,8 +460,18 @@ func (r *CodeBlockRule) GenerateContent(builder *strings.Builder, element schema
 			}
 			// Generate the minimum required number of code blocks
 			for i := 0; i < rule.Min; i++ {
-				fmt.Fprintf(builder, "```%s\n", lang)
+				builder.WriteString("```" + lang)
+				for _, attr := range rule.RequiredAttributes {
+					fmt.Fprintf(builder, " %s=\"TODO\"", attr)
+				}
+				builder.WriteString("\n")
 				builder.WriteString(commentForLang(lang) + "\n")
+				// Literal text the block must contain
+				for _, pattern := range rule.Contains {
+					if pattern.Literal != "" {
+						builder.WriteString(pattern.Literal + "\n")
+					}
+				}
 				builder.WriteString("```\n\n")
 			}
 		}
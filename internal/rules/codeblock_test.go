@@ -230,3 +230,293 @@ func TestCodeBlockRuleGenerateContentNoRules(t *testing.T) {
 		t.Error("GenerateContent() should return false when no code block rules")
 	}
 }
+
+func TestCodeBlockRuleSeverity(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Title\n\nNo code here.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		ruleSeverity    string
+		elementSeverity string
+		want            Severity
+	}{
+		{"default", "", "", SeverityError},
+		{"rule severity", "info", "", SeverityInfo},
+		{"element severity", "", "warning", SeverityWarning},
+		{"rule overrides element", "info", "warning", SeverityInfo},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading:  schema.HeadingPattern{Pattern: "# Title"},
+						Severity: tt.elementSeverity,
+						SectionRules: &schema.SectionRules{
+							CodeBlocks: []schema.CodeBlockRule{
+								{Lang: "go", Min: 1, Severity: tt.ruleSeverity},
+							},
+						},
+					},
+				},
+			}
+
+			ctx := vast.NewContext(doc, s, "")
+			violations := NewCodeBlockRule().ValidateWithContext(ctx)
+
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d", len(violations))
+			}
+			if violations[0].Severity != tt.want {
+				t.Errorf("Severity = %q, want %q", violations[0].Severity, tt.want)
+			}
+		})
+	}
+}
+
+func TestCodeBlockRuleValidSyntax(t *testing.T) {
+	tests := []struct {
+		name     string
+		lang     string
+		body     string
+		wantLine int // 0 means no violation
+	}{
+		{"valid json", "json", "{\n  \"a\": 1\n}\n", 0},
+		{"json trailing comma", "json", "{\n  \"a\": 1,\n}\n", 6},
+		{"json trailing data", "json", "{}\n{}\n", 5},
+		{"valid yaml", "yaml", "a: 1\nb: [1, 2]\n", 0},
+		{"yaml bad mapping", "yaml", "a: 1\nb: c: d\n", 5},
+		{"valid toml", "toml", "[server]\nport = 8080\n", 0},
+		{"toml missing value", "toml", "a = 1\nb =\n", 5},
+		{"go file", "go", "package main\n\nfunc main() {}\n", 0},
+		{"go declarations", "go", "func add(a, b int) int {\n\treturn a + b\n}\n", 0},
+		{"go statements", "go", "x := 1\nfmt.Println(x)\n", 0},
+		{"go statement error", "go", "x := 1\nfmt.Println(x\n", 5},
+		{"go declaration error", "go", "func f() {\n\treturn )\n}\n", 5},
+		{"unsupported language", "python", "def (:\n", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Code block body starts on line 4
+			content := "# Title\n\n```" + tt.lang + "\n" + tt.body + "```\n"
+			doc, err := parser.New().Parse("test.md", []byte(content))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading: schema.HeadingPattern{Pattern: "# Title"},
+						SectionRules: &schema.SectionRules{
+							CodeBlocks: []schema.CodeBlockRule{{Lang: tt.lang, ValidSyntax: true}},
+						},
+					},
+				},
+			}
+
+			violations := NewCodeBlockRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if tt.wantLine == 0 {
+				if len(violations) != 0 {
+					t.Fatalf("expected no violations, got %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d: %v", len(violations), violations)
+			}
+			if violations[0].Code != CodeInvalidCodeSyntax {
+				t.Errorf("Code = %s, want %s", violations[0].Code, CodeInvalidCodeSyntax)
+			}
+			if violations[0].Line != tt.wantLine {
+				t.Errorf("Line = %d, want %d (%s)", violations[0].Line, tt.wantLine, violations[0].Message)
+			}
+		})
+	}
+}
+
+func TestCodeBlockRuleBlockChecks(t *testing.T) {
+	// Block bodies start on lines 4 (bash), 8 (go) and 14 (unlabelled)
+	content := "# Title\n\n```bash\ncurl -H 'Authorization: ghp_abc123' https://api\n```\n\n```go\npackage main\n\nfunc main() {}\n```\n\n```\nplain\n```\n"
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		rule     schema.CodeBlockRule
+		wantCode Code
+		wantLine int // 0 means no violation
+	}{
+		{"contains literal", schema.CodeBlockRule{Lang: "bash", Contains: []schema.RequiredTextPattern{{Literal: "curl"}}}, "", 0},
+		{"contains missing", schema.CodeBlockRule{Lang: "bash", Contains: []schema.RequiredTextPattern{{Literal: "go install"}}}, CodeMissingCodeText, 4},
+		{"contains regex", schema.CodeBlockRule{Lang: "go", Contains: []schema.RequiredTextPattern{{Pattern: `func \w+\(`}}}, "", 0},
+		{"not_contains regex", schema.CodeBlockRule{NotContains: []schema.ForbiddenTextPattern{{Pattern: `ghp_[a-z0-9]+`}}}, CodeForbiddenCodeText, 4},
+		{"not_contains absent", schema.CodeBlockRule{Lang: "go", NotContains: []schema.ForbiddenTextPattern{{Literal: "TODO"}}}, "", 0},
+		{"min_lines", schema.CodeBlockRule{Lang: "go", MinLines: 4}, CodeCodeBlockTooShort, 8},
+		{"max_lines", schema.CodeBlockRule{Lang: "go", MaxLines: 2}, CodeCodeBlockTooLong, 8},
+		{"lines within limits", schema.CodeBlockRule{Lang: "go", MinLines: 3, MaxLines: 3}, "", 0},
+		{"require_lang", schema.CodeBlockRule{RequireLang: true}, CodeMissingCodeLang, 14},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading:      schema.HeadingPattern{Pattern: "# Title"},
+						SectionRules: &schema.SectionRules{CodeBlocks: []schema.CodeBlockRule{tt.rule}},
+					},
+				},
+			}
+
+			violations := NewCodeBlockRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if tt.wantLine == 0 {
+				if len(violations) != 0 {
+					t.Fatalf("expected no violations, got %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d: %v", len(violations), violations)
+			}
+			if violations[0].Code != tt.wantCode || violations[0].Line != tt.wantLine {
+				t.Errorf("got %s at line %d, want %s at line %d", violations[0].Code, violations[0].Line, tt.wantCode, tt.wantLine)
+			}
+		})
+	}
+}
+
+func TestCodeBlockRulePatternSeverity(t *testing.T) {
+	doc, err := parser.New().Parse("test.md", []byte("# Title\n\n```bash\necho TODO\n```\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Pattern: "# Title"},
+				SectionRules: &schema.SectionRules{
+					CodeBlocks: []schema.CodeBlockRule{{
+						Severity: "warning",
+						NotContains: []schema.ForbiddenTextPattern{
+							{Literal: "TODO"},
+							{Literal: "echo", Severity: "info"},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	violations := NewCodeBlockRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %d: %v", len(violations), violations)
+	}
+	// The pattern severity wins, then the code block rule's
+	if violations[0].Severity != "warning" || violations[1].Severity != "info" {
+		t.Errorf("severities = %q, %q, want warning, info", violations[0].Severity, violations[1].Severity)
+	}
+	if violations[0].Column != 6 {
+		t.Errorf("Column = %d, want 6", violations[0].Column)
+	}
+}
+
+func TestCodeBlockRuleAttributes(t *testing.T) {
+	// Block bodies start on lines 4 and 8
+	content := "# Title\n\n```go title=\"main.go\" {2}\npackage main\n```\n\n```go linenos\npackage main\n```\n"
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		rule      schema.CodeBlockRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"lang matches language only", schema.CodeBlockRule{Lang: "go", Min: 2}, nil, nil},
+		{"required attribute", schema.CodeBlockRule{Lang: "go", RequiredAttributes: []string{"title"}}, []Code{CodeMissingCodeAttribute}, []int{8}},
+		{"allowed attributes", schema.CodeBlockRule{AllowedAttributes: []string{"title"}}, []Code{CodeUnexpectedCodeAttribute}, []int{8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading:      schema.HeadingPattern{Pattern: "# Title"},
+						SectionRules: &schema.SectionRules{CodeBlocks: []schema.CodeBlockRule{tt.rule}},
+					},
+				},
+			}
+
+			violations := NewCodeBlockRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCodeBlockRuleLanguageAliases(t *testing.T) {
+	// Block bodies start on lines 4, 8 and 12
+	content := "# Title\n\n```sh\necho one\n```\n\n```shell\necho two\n```\n\n```python\nprint()\n```\n"
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	aliases := map[string][]string{"bash": {"sh", "shell", "console"}}
+	tests := []struct {
+		name      string
+		config    *schema.CodeBlocksConfig
+		rule      *schema.CodeBlockRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"no aliases", nil, &schema.CodeBlockRule{Lang: "bash", Min: 1}, []Code{CodeTooFewCodeBlocks}, []int{1}},
+		{"aliases count as canonical", &schema.CodeBlocksConfig{Aliases: aliases}, &schema.CodeBlockRule{Lang: "bash", Min: 2}, nil, nil},
+		{"rule may use a synonym", &schema.CodeBlocksConfig{Aliases: aliases}, &schema.CodeBlockRule{Lang: "console", Max: 1}, []Code{CodeTooManyCodeBlocks}, []int{1}},
+		{"allowed languages", &schema.CodeBlocksConfig{Aliases: aliases, AllowedLanguages: []string{"bash", "go"}}, nil, []Code{CodeUnknownCodeLanguage}, []int{12}},
+		{"allowed languages without aliases", &schema.CodeBlocksConfig{AllowedLanguages: []string{"bash", "python"}}, nil, []Code{CodeUnknownCodeLanguage, CodeUnknownCodeLanguage}, []int{4, 8}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				CodeBlocks: tt.config,
+				Structure: []schema.StructureElement{
+					{Heading: schema.HeadingPattern{Pattern: "# Title"}},
+				},
+			}
+			if tt.rule != nil {
+				s.Structure[0].SectionRules = &schema.SectionRules{CodeBlocks: []schema.CodeBlockRule{*tt.rule}}
+			}
+
+			violations := NewCodeBlockRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,881 @@
+package rules
+
+import (
+	"sort"
+	"strings"
+)
+
+// Code is a stable identifier for a distinct check performed by a rule.
+// Codes never change meaning once published, so they are safe to reference
+// in schemas (rules map), CI configuration, and documentation. New checks
+// take the next free number in their range; TestCodesAreFrozen pins every
+// existing code so renumbering fails the build.
+type Code string
+
+// Structure checks (MDS1xx)
+const (
+	CodeMissingSection     Code = "MDS101"
+	CodeOutOfOrder         Code = "MDS102"
+	CodeUnexpectedSection  Code = "MDS103"
+	CodeTooFewOccurrences  Code = "MDS104"
+	CodeTooManyOccurrences Code = "MDS105"
+	CodeWrongFirstHeading  Code = "MDS106"
+	CodeHeadingExprError   Code = "MDS107"
+)
+
+// Section content checks (MDS2xx). Each element has its own decade:
+//
+//	MDS20x  required and forbidden text
+//	MDS21x  code block counts, contents, and syntax
+//	MDS22x  images
+//	MDS23x  table shape (counts, columns, headers, rows)
+//	MDS24x  lists
+//	MDS25x  word counts
+//	MDS26x  paragraphs
+//	MDS27x  code block fence info (language and attributes)
+//	MDS28x  table cell contents
+const (
+	CodeMissingRequiredText     Code = "MDS201"
+	CodeForbiddenText           Code = "MDS202"
+	CodeTooFewCodeBlocks        Code = "MDS211"
+	CodeTooManyCodeBlocks       Code = "MDS212"
+	CodeInvalidCodeSyntax       Code = "MDS213"
+	CodeMissingCodeText         Code = "MDS214"
+	CodeForbiddenCodeText       Code = "MDS215"
+	CodeCodeBlockTooShort       Code = "MDS216"
+	CodeCodeBlockTooLong        Code = "MDS217"
+	CodeTooFewImages            Code = "MDS221"
+	CodeTooManyImages           Code = "MDS222"
+	CodeMissingImageAlt         Code = "MDS223"
+	CodeMissingImageFile        Code = "MDS224"
+	CodeImageFileTooLarge       Code = "MDS225"
+	CodeImageDimensionsTooLarge Code = "MDS226"
+	CodeShortImageAlt           Code = "MDS227"
+	CodeUninformativeImageAlt   Code = "MDS228"
+	CodeUnreadableImageFile     Code = "MDS229"
+	CodeTooFewTables            Code = "MDS231"
+	CodeTooManyTables           Code = "MDS232"
+	CodeTooFewTableColumns      Code = "MDS233"
+	CodeMissingTableHeader      Code = "MDS234"
+	CodeTooFewTableRows         Code = "MDS235"
+	CodeTooManyTableRows        Code = "MDS236"
+	CodeTableHeadersOutOfOrder  Code = "MDS237"
+	CodeUnexpectedTableHeader   Code = "MDS238"
+	CodeTooFewLists             Code = "MDS241"
+	CodeTooManyLists            Code = "MDS242"
+	CodeTooFewListItems         Code = "MDS243"
+	CodeTooManyListItems        Code = "MDS244"
+	CodeInvalidListItem         Code = "MDS245"
+	CodeListTooDeep             Code = "MDS246"
+	CodeUncheckedTask           Code = "MDS247"
+	CodeCheckedTask             Code = "MDS248"
+	CodeTooFewWords             Code = "MDS251"
+	CodeTooManyWords            Code = "MDS252"
+	CodeTooFewParagraphs        Code = "MDS261"
+	CodeTooManyParagraphs       Code = "MDS262"
+	CodeMissingCodeLang         Code = "MDS271"
+	CodeUnknownCodeLanguage     Code = "MDS272"
+	CodeMissingCodeAttribute    Code = "MDS273"
+	CodeUnexpectedCodeAttribute Code = "MDS274"
+	CodeEmptyTableCell          Code = "MDS281"
+	CodeInvalidTableCell        Code = "MDS282"
+	CodeTableExtraEntry         Code = "MDS283"
+	CodeTableMissingEntry       Code = "MDS284"
+	CodeTableKeysNotArray       Code = "MDS285"
+)
+
+// Heading checks (MDS3xx)
+const (
+	CodeMultipleH1                 Code = "MDS301"
+	CodeSkippedHeadingLevel        Code = "MDS302"
+	CodeDuplicateHeading           Code = "MDS303"
+	CodeDuplicateHeadingInLevel    Code = "MDS304"
+	CodeHeadingTooDeep             Code = "MDS305"
+	CodeHeadingCase                Code = "MDS306"
+	CodeHeadingTrailingPunctuation Code = "MDS307"
+	CodeHeadingTooLong             Code = "MDS308"
+	CodeHeadingEmphasis            Code = "MDS309"
+	CodeHeadingCode                Code = "MDS310"
+)
+
+// Link checks (MDS4xx)
+const (
+	CodeBrokenAnchor       Code = "MDS401"
+	CodeBrokenFileLink     Code = "MDS402"
+	CodeBlockedDomain      Code = "MDS403"
+	CodeDomainNotAllowed   Code = "MDS404"
+	CodeInvalidURL         Code = "MDS405"
+	CodeUnreachableURL     Code = "MDS406"
+	CodeURLErrorStatus     Code = "MDS407"
+	CodeForbiddenLinkText  Code = "MDS408"
+	CodeURLAsLinkText      Code = "MDS409"
+	CodeInsecureLink       Code = "MDS410"
+	CodeDisallowedScheme   Code = "MDS411"
+	CodePreferRelativeLink Code = "MDS412"
+)
+
+// Frontmatter checks (MDS5xx)
+const (
+	CodeMissingFrontmatter     Code = "MDS501"
+	CodeInvalidFrontmatter     Code = "MDS502"
+	CodeMissingField           Code = "MDS503"
+	CodeWrongFieldType         Code = "MDS504"
+	CodeWrongFieldFormat       Code = "MDS505"
+	CodeValueNotAllowed        Code = "MDS506"
+	CodeFieldPatternMismatch   Code = "MDS507"
+	CodeFieldTooShort          Code = "MDS508"
+	CodeFieldTooLong           Code = "MDS509"
+	CodeFieldBelowMinimum      Code = "MDS510"
+	CodeFieldAboveMaximum      Code = "MDS511"
+	CodeTooFewFieldItems       Code = "MDS512"
+	CodeTooManyFieldItems      Code = "MDS513"
+	CodeDuplicateFieldItems    Code = "MDS514"
+	CodeInvalidFieldItem       Code = "MDS515"
+	CodeDeprecatedField        Code = "MDS516"
+	CodeJSONSchemaViolation    Code = "MDS517"
+	CodeInvalidJSONSchema      Code = "MDS518"
+	CodeUnknownField           Code = "MDS519"
+	CodeAssertionFailed        Code = "MDS520"
+	CodeInvalidAssertion       Code = "MDS521"
+	CodeWrongFrontmatterFormat Code = "MDS522"
+	CodeContentMismatch        Code = "MDS523"
+)
+
+// Document prose checks (MDS6xx)
+const (
+	CodeDiscouragedTerm Code = "MDS601"
+)
+
+// CodeInfo documents a check for `mdschema explain`
+type CodeInfo struct {
+	Code      Code
+	Name      string // Short kebab-case name, e.g. "out-of-order"
+	Rule      string // Name of the rule reporting the check
+	Summary   string // One-line description
+	Rationale string // Why the check exists
+	Example   string // Schema and/or Markdown triggering the check
+	Fix       string // How to resolve a violation
+}
+
+// codeInfos lists every code, in code order
+var codeInfos = []CodeInfo{
+	{
+		Code: CodeMissingSection, Name: "missing-section", Rule: "structure",
+		Summary:   "A required section defined in the schema is not in the document.",
+		Rationale: "Readers and tools rely on every document of a kind having the same sections.",
+		Example:   "structure:\n  - heading: \"# Runbook\"\n    children:\n      - heading: \"## Rollback\"\n\n# Runbook\n(no \"## Rollback\" section)",
+		Fix:       "Add the section under the expected parent, or mark the element `optional: true` in the schema.",
+	},
+	{
+		Code: CodeOutOfOrder, Name: "out-of-order", Rule: "structure",
+		Summary:   "A section appears before a sibling that the schema lists ahead of it.",
+		Rationale: "A consistent section order lets readers find information in the same place in every document.",
+		Example:   "structure:\n  - heading: \"## Installation\"\n  - heading: \"## Usage\"\n\n## Usage\n## Installation",
+		Fix:       "Move the section after the one named in the message, following the order in the schema.",
+	},
+	{
+		Code: CodeUnexpectedSection, Name: "unexpected-section", Rule: "structure",
+		Summary:   "A heading does not match any element defined in the schema.",
+		Rationale: "Sections outside the schema are often typos of expected headings or content that belongs elsewhere.",
+		Example:   "structure:\n  - heading: \"## Usage\"\n\n## Usgae",
+		Fix:       "Rename the heading to match the schema, remove it, or set `allow_additional: true` on the parent element.",
+	},
+	{
+		Code: CodeTooFewOccurrences, Name: "too-few-occurrences", Rule: "structure",
+		Summary:   "An element with a `count` matches fewer sections than its minimum.",
+		Rationale: "Repeated sections (e.g. one per API endpoint) often have a required minimum.",
+		Example:   "structure:\n  - heading: {pattern: \"## Endpoint .*\"}\n    count: {min: 2}\n\n## Endpoint list",
+		Fix:       "Add sections matching the element's heading, or lower `count.min`.",
+	},
+	{
+		Code: CodeTooManyOccurrences, Name: "too-many-occurrences", Rule: "structure",
+		Summary:   "An element with a `count` matches more sections than its maximum.",
+		Rationale: "Limits keep repeated sections from growing without bound.",
+		Example:   "structure:\n  - heading: {pattern: \"## Example .*\"}\n    count: {min: 1, max: 3}",
+		Fix:       "Merge or remove sections, or raise `count.max`.",
+	},
+	{
+		Code: CodeWrongFirstHeading, Name: "wrong-first-heading", Rule: "structure",
+		Summary:   "The first heading at a level does not match the first required element.",
+		Rationale: "Documents should open with the section the schema expects, such as a title matching the filename.",
+		Example:   "structure:\n  - heading: {expr: \"slug(filename) == slug(heading)\"}\n\nmy-tool.md:\n# Something Else",
+		Fix:       "Rename or reorder the first heading to match the schema.",
+	},
+	{
+		Code: CodeHeadingExprError, Name: "heading-expr-error", Rule: "structure",
+		Summary:   "A heading expression failed to evaluate against a section.",
+		Rationale: "A failing expression cannot match, so the section would be reported as missing or unexpected without saying why.",
+		Example:   "structure:\n  - heading: {expr: \"fm.version > 1\"}\n\n---\nversion: \"2\"\n---",
+		Fix:       "Guard or convert frontmatter values (e.g. fm.version ?? 0) and make sure the expression returns a bool.",
+	},
+	{
+		Code: CodeMissingRequiredText, Name: "missing-required-text", Rule: "required-text",
+		Summary:   "A section does not contain text the schema requires.",
+		Rationale: "Some sections must mention specific things, such as a license name or a command.",
+		Example:   "- heading: \"## License\"\n  required_text: [\"MIT\"]",
+		Fix:       "Add the required text (or text matching the pattern) to the section.",
+	},
+	{
+		Code: CodeForbiddenText, Name: "forbidden-text", Rule: "forbidden-text",
+		Summary:   "A section contains text the schema forbids.",
+		Rationale: "Placeholders such as TODO or internal references should not be published.",
+		Example:   "- heading: \"## Usage\"\n  forbidden_text: [\"TODO\"]",
+		Fix:       "Remove or reword the forbidden text.",
+	},
+	{
+		Code: CodeTooFewCodeBlocks, Name: "too-few-code-blocks", Rule: "codeblock",
+		Summary:   "A section has fewer code blocks (of a language) than required.",
+		Rationale: "Usage and installation sections are most useful with runnable examples.",
+		Example:   "- heading: \"## Usage\"\n  code_blocks:\n    - {lang: go, min: 1}",
+		Fix:       "Add a fenced code block with the required language tag, e.g. ```go.",
+	},
+	{
+		Code: CodeTooManyCodeBlocks, Name: "too-many-code-blocks", Rule: "codeblock",
+		Summary:   "A section has more code blocks (of a language) than allowed.",
+		Rationale: "Long runs of code bury the explanation around them.",
+		Example:   "- heading: \"## Overview\"\n  code_blocks:\n    - {max: 1}",
+		Fix:       "Remove or merge code blocks, or move them to a more specific section.",
+	},
+	{
+		Code: CodeInvalidCodeSyntax, Name: "invalid-code-syntax", Rule: "codeblock",
+		Summary:   "A json, yaml, toml, or go code block does not parse.",
+		Rationale: "Readers copy examples verbatim; a trailing comma or unbalanced brace breaks them.",
+		Example:   "- heading: \"## Configuration\"\n  code_blocks:\n    - {lang: json, valid_syntax: true}",
+		Fix:       "Fix the syntax error at the reported line, or change the fence language if the block is not meant to parse.",
+	},
+	{
+		Code: CodeMissingCodeText, Name: "missing-code-text", Rule: "codeblock",
+		Summary:   "A code block does not contain text the schema requires.",
+		Rationale: "Some examples must show a specific command or call, such as `go install` in installation steps.",
+		Example:   "- heading: \"## Installation\"\n  code_blocks:\n    - {lang: bash, contains: [\"go install\"]}",
+		Fix:       "Add the required text to the code block.",
+	},
+	{
+		Code: CodeForbiddenCodeText, Name: "forbidden-code-text", Rule: "codeblock",
+		Summary:   "A code block contains text the schema forbids.",
+		Rationale: "Examples are copied verbatim, so hard-coded tokens or internal hosts leak easily.",
+		Example:   "code_blocks:\n  - not_contains:\n      - {pattern: \"ghp_[A-Za-z0-9]+\"}",
+		Fix:       "Replace the forbidden text with a placeholder such as $TOKEN.",
+	},
+	{
+		Code: CodeCodeBlockTooShort, Name: "code-block-too-short", Rule: "codeblock",
+		Summary:   "A code block has fewer lines than required.",
+		Rationale: "Empty or one-line placeholders rarely make a useful example.",
+		Example:   "code_blocks:\n  - {lang: go, min_lines: 3}",
+		Fix:       "Expand the example or use inline code instead.",
+	},
+	{
+		Code: CodeCodeBlockTooLong, Name: "code-block-too-long", Rule: "codeblock",
+		Summary:   "A code block has more lines than allowed.",
+		Rationale: "Long listings are hard to follow in prose; they belong in example files.",
+		Example:   "code_blocks:\n  - {max_lines: 40}",
+		Fix:       "Trim the example to the relevant part or link to a full source file.",
+	},
+	{
+		Code: CodeTooFewImages, Name: "too-few-images", Rule: "image",
+		Summary:   "A section has fewer images (of the allowed formats) than required.",
+		Rationale: "Some sections, like screenshots or architecture, need a picture.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {min: 1, formats: [png]}",
+		Fix:       "Add an image in one of the allowed formats.",
+	},
+	{
+		Code: CodeTooManyImages, Name: "too-many-images", Rule: "image",
+		Summary:   "A section has more images than allowed.",
+		Rationale: "Too many images make a section slow to load and hard to scan.",
+		Example:   "- heading: \"## Overview\"\n  images:\n    - {max: 2}",
+		Fix:       "Remove images or move them to a dedicated section.",
+	},
+	{
+		Code: CodeMissingImageAlt, Name: "missing-image-alt", Rule: "image",
+		Summary:   "An image has no alt text.",
+		Rationale: "Alt text is read by screen readers and shown when the image fails to load.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {require_alt: true}\n\n![](screenshot.png)",
+		Fix:       "Describe the image between the brackets: ![Settings page](screenshot.png).",
+	},
+	{
+		Code: CodeMissingImageFile, Name: "missing-image-file", Rule: "image",
+		Summary:   "A local image file does not exist.",
+		Rationale: "A broken image path renders as a broken icon or just the alt text.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {validate_files: true}\n\n![Settings page](./img/missing.png)",
+		Fix:       "Fix the path (relative to the document, or to the schema directory when it starts with /) or add the file.",
+	},
+	{
+		Code: CodeImageFileTooLarge, Name: "image-file-too-large", Rule: "image",
+		Summary:   "A local image file is larger than max_file_size.",
+		Rationale: "Large images slow down page loads and bloat the repository.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {max_file_size: 500KB}",
+		Fix:       "Compress or resize the image, or convert it to a more efficient format.",
+	},
+	{
+		Code: CodeImageDimensionsTooLarge, Name: "image-dimensions-too-large", Rule: "image",
+		Summary:   "A local image is wider or taller than max_width/max_height.",
+		Rationale: "Oversized images are scaled down by the browser anyway and waste bandwidth.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {max_width: 1600, max_height: 1200}",
+		Fix:       "Resize the image to fit within the limits.",
+	},
+	{
+		Code: CodeShortImageAlt, Name: "short-image-alt", Rule: "image",
+		Summary:   "An image's alt text is shorter than min_alt_length.",
+		Rationale: "One-word alt text rarely tells a screen reader user what the image shows.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {min_alt_length: 10}\n\n![UI](ui.png)",
+		Fix:       "Describe what the image shows: ![Settings page with dark mode enabled](ui.png).",
+	},
+	{
+		Code: CodeUninformativeImageAlt, Name: "uninformative-image-alt", Rule: "image",
+		Summary:   "An image's alt text is a generic word from alt_forbidden.",
+		Rationale: "Alt text such as \"image\" or \"screenshot\" tells the reader nothing about the content.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {alt_forbidden: [image, screenshot]}\n\n![Screenshot](ui.png)",
+		Fix:       "Describe what the image shows instead of what it is.",
+	},
+	{
+		Code: CodeUnreadableImageFile, Name: "unreadable-image-file", Rule: "image",
+		Summary:   "A local image path is a directory or cannot be read.",
+		Rationale: "An image that cannot be read will not render, even though the path exists.",
+		Example:   "- heading: \"## Screenshots\"\n  images:\n    - {validate_files: true}\n\n![Settings page](./img)",
+		Fix:       "Point the image at a file, or fix the file's permissions.",
+	},
+	{
+		Code: CodeTooFewTables, Name: "too-few-tables", Rule: "table",
+		Summary:   "A section has fewer tables than required.",
+		Rationale: "Reference sections such as options or parameters are expected to be tabular.",
+		Example:   "- heading: \"## Options\"\n  tables:\n    - {min: 1}",
+		Fix:       "Add a Markdown table to the section.",
+	},
+	{
+		Code: CodeTooManyTables, Name: "too-many-tables", Rule: "table",
+		Summary:   "A section has more tables than allowed.",
+		Rationale: "Several tables in one section usually means it should be split.",
+		Example:   "- heading: \"## Options\"\n  tables:\n    - {max: 1}",
+		Fix:       "Merge the tables or split the section.",
+	},
+	{
+		Code: CodeTooFewTableColumns, Name: "too-few-table-columns", Rule: "table",
+		Summary:   "A table has fewer columns than required.",
+		Rationale: "Reference tables need enough columns to describe each row.",
+		Example:   "- heading: \"## Options\"\n  tables:\n    - {min_columns: 2}",
+		Fix:       "Add the missing columns to the table.",
+	},
+	{
+		Code: CodeMissingTableHeader, Name: "missing-table-header", Rule: "table",
+		Summary:   "A table is missing a required column header.",
+		Rationale: "Consistent headers make tables comparable across documents and machine-readable.",
+		Example:   "- heading: \"## Options\"\n  tables:\n    - {required_headers: [Name, Description]}",
+		Fix:       "Add a column with the required header (matching is case-insensitive).",
+	},
+	{
+		Code: CodeTooFewTableRows, Name: "too-few-table-rows", Rule: "table",
+		Summary:   "A table has fewer body rows than required.",
+		Rationale: "A reference table with no entries, or only a placeholder, documents nothing.",
+		Example:   "tables:\n  - {min_rows: 1}",
+		Fix:       "Add rows to the table.",
+	},
+	{
+		Code: CodeTooManyTableRows, Name: "too-many-table-rows", Rule: "table",
+		Summary:   "A table has more body rows than allowed.",
+		Rationale: "Very long tables are hard to scan and are often better split up.",
+		Example:   "tables:\n  - {max_rows: 20}",
+		Fix:       "Split the table or move rarely used entries elsewhere.",
+	},
+	{
+		Code: CodeTableHeadersOutOfOrder, Name: "table-headers-out-of-order", Rule: "table",
+		Summary:   "A table's required headers are not in the listed order.",
+		Rationale: "Tables with the same columns in the same order are easier to compare across pages.",
+		Example:   "tables:\n  - {required_headers: [Name, Type, Default], headers_in_order: true}",
+		Fix:       "Reorder the table columns to match required_headers.",
+	},
+	{
+		Code: CodeUnexpectedTableHeader, Name: "unexpected-table-header", Rule: "table",
+		Summary:   "A table has a header that is not in required_headers while exact_headers is set.",
+		Rationale: "Reference tables often have a fixed shape that tooling relies on.",
+		Example:   "tables:\n  - {required_headers: [Name, Description], exact_headers: true}",
+		Fix:       "Remove the extra column or add it to required_headers.",
+	},
+	{
+		Code: CodeTooFewLists, Name: "too-few-lists", Rule: "list",
+		Summary:   "A section has fewer lists (of a type) than required.",
+		Rationale: "Steps and feature summaries read best as lists.",
+		Example:   "- heading: \"## Steps\"\n  lists:\n    - {min: 1, type: ordered}",
+		Fix:       "Add a list of the required type (1. for ordered, - for unordered).",
+	},
+	{
+		Code: CodeTooManyLists, Name: "too-many-lists", Rule: "list",
+		Summary:   "A section has more lists (of a type) than allowed.",
+		Rationale: "Many separate lists are usually better merged or split into subsections.",
+		Example:   "- heading: \"## Features\"\n  lists:\n    - {max: 1}",
+		Fix:       "Merge the lists or split the section.",
+	},
+	{
+		Code: CodeTooFewListItems, Name: "too-few-list-items", Rule: "list",
+		Summary:   "A list has fewer items than required.",
+		Rationale: "A one-item list is usually an unfinished list or a sentence in disguise.",
+		Example:   "- heading: \"## Features\"\n  lists:\n    - {min_items: 3}",
+		Fix:       "Add items or turn the list into prose.",
+	},
+	{
+		Code: CodeTooManyListItems, Name: "too-many-list-items", Rule: "list",
+		Summary:   "A list has more items than allowed.",
+		Rationale: "Long lists are hard to scan and often hide structure that deserves subsections.",
+		Example:   "- heading: \"## Highlights\"\n  lists:\n    - {max_items: 5}",
+		Fix:       "Trim the list or group items under subsections.",
+	},
+	{
+		Code: CodeInvalidListItem, Name: "invalid-list-item", Rule: "list",
+		Summary:   "A list item does not match the required item_pattern.",
+		Rationale: "Changelogs and indexes stay consistent when every entry follows the same format.",
+		Example:   "- heading: \"## Changes\"\n  lists:\n    - {item_pattern: '^\\[#\\d+\\]'}",
+		Fix:       "Rewrite the item to match the pattern.",
+	},
+	{
+		Code: CodeListTooDeep, Name: "list-too-deep", Rule: "list",
+		Summary:   "A list is nested deeper than max_depth.",
+		Rationale: "Deeply nested lists are hard to read and render inconsistently.",
+		Example:   "- heading: \"## Steps\"\n  lists:\n    - {max_depth: 2}",
+		Fix:       "Flatten the list or move nested detail into prose or subsections.",
+	},
+	{
+		Code: CodeUncheckedTask, Name: "unchecked-task", Rule: "list",
+		Summary:   "A task list item is unchecked where all tasks must be checked.",
+		Rationale: "Release checklists must be complete before the document is merged.",
+		Example:   "- heading: \"## Release Checklist\"\n  lists:\n    - {tasks: all_checked}",
+		Fix:       "Complete the task and check it ([x]), or remove it.",
+	},
+	{
+		Code: CodeCheckedTask, Name: "checked-task", Rule: "list",
+		Summary:   "A task list item is checked where all tasks must be unchecked.",
+		Rationale: "Templates must start with every task open so nothing is skipped by accident.",
+		Example:   "- heading: \"## Checklist\"\n  lists:\n    - {tasks: none_checked}",
+		Fix:       "Uncheck the task ([ ]).",
+	},
+	{
+		Code: CodeTooFewWords, Name: "too-few-words", Rule: "word-count",
+		Summary:   "A section has fewer words than required.",
+		Rationale: "Very short sections are often placeholders that were never filled in.",
+		Example:   "- heading: \"## Overview\"\n  word_count: {min: 50}",
+		Fix:       "Expand the section to at least the minimum word count.",
+	},
+	{
+		Code: CodeTooManyWords, Name: "too-many-words", Rule: "word-count",
+		Summary:   "A section has more words than allowed.",
+		Rationale: "Summaries and introductions should stay short.",
+		Example:   "- heading: \"## Overview\"\n  word_count: {max: 300}",
+		Fix:       "Shorten the section or move details into subsections.",
+	},
+	{
+		Code: CodeTooFewParagraphs, Name: "too-few-paragraphs", Rule: "paragraph",
+		Summary:   "A section has fewer prose paragraphs than required.",
+		Rationale: "Sections consisting only of lists or code lack the explanation readers need.",
+		Example:   "- heading: \"## Overview\"\n  paragraphs: {min: 1}",
+		Fix:       "Add a paragraph of prose to the section.",
+	},
+	{
+		Code: CodeTooManyParagraphs, Name: "too-many-paragraphs", Rule: "paragraph",
+		Summary:   "A section has more prose paragraphs than allowed.",
+		Rationale: "Long prose in a summary section should be split or condensed.",
+		Example:   "- heading: \"## Summary\"\n  paragraphs: {max: 2}",
+		Fix:       "Condense the paragraphs or move details into subsections.",
+	},
+	{
+		Code: CodeMissingCodeLang, Name: "missing-code-lang", Rule: "codeblock",
+		Summary:   "A fenced code block has no language identifier.",
+		Rationale: "The language drives syntax highlighting and lets other code block rules select the block.",
+		Example:   "code_blocks:\n  - {require_lang: true}",
+		Fix:       "Add a language after the opening fence, e.g. ```bash.",
+	},
+	{
+		Code: CodeUnknownCodeLanguage, Name: "unknown-code-language", Rule: "codeblock",
+		Summary:   "A fenced code block uses a language outside the global allowed_languages list.",
+		Rationale: "Mixing sh, shell, and bash for the same thing makes examples inconsistent and harder to select.",
+		Example:   "code_blocks:\n  aliases:\n    bash: [sh, shell]\n  allowed_languages: [bash, go, yaml]",
+		Fix:       "Use an allowed language or one of its aliases, or add the language to allowed_languages.",
+	},
+	{
+		Code: CodeMissingCodeAttribute, Name: "missing-code-attribute", Rule: "codeblock",
+		Summary:   "A code block's info string lacks a required attribute.",
+		Rationale: "Doc toolchains use attributes such as title to label examples consistently.",
+		Example:   "code_blocks:\n  - {lang: go, required_attributes: [title]}",
+		Fix:       "Add the attribute after the language, e.g. ```go title=\"main.go\".",
+	},
+	{
+		Code: CodeUnexpectedCodeAttribute, Name: "unexpected-code-attribute", Rule: "codeblock",
+		Summary:   "A code block's info string has an attribute outside the allowed list.",
+		Rationale: "Unknown attributes are usually typos or options the site generator ignores.",
+		Example:   "code_blocks:\n  - {allowed_attributes: [title, showLineNumbers]}",
+		Fix:       "Remove or rename the attribute.",
+	},
+	{
+		Code: CodeEmptyTableCell, Name: "empty-table-cell", Rule: "table",
+		Summary:   "A table body cell is empty.",
+		Rationale: "Empty cells usually mean a value or description was forgotten.",
+		Example:   "tables:\n  - {no_empty_cells: true}",
+		Fix:       "Fill in the cell, using a dash or \"none\" when there is deliberately no value.",
+	},
+	{
+		Code: CodeInvalidTableCell, Name: "invalid-table-cell", Rule: "table",
+		Summary:   "A table cell does not match its column's enum or pattern.",
+		Rationale: "Columns such as Type or Default have a fixed vocabulary that should stay consistent.",
+		Example:   "tables:\n  - columns:\n      Type: {enum: [string, int, bool]}\n      Name: {pattern: \"^[a-z_]+$\"}",
+		Fix:       "Use one of the allowed values or match the pattern.",
+	},
+	{
+		Code: CodeTableExtraEntry, Name: "table-extra-entry", Rule: "table",
+		Summary:   "A table lists a key that is not in the code block or frontmatter array it is matched against.",
+		Rationale: "Reference tables and configuration examples drift apart when options are renamed or removed.",
+		Example:   "tables:\n  - match_keys: {column: Option, code_block: yaml}",
+		Fix:       "Remove the row, fix its spelling, or add the key to the example.",
+	},
+	{
+		Code: CodeTableMissingEntry, Name: "table-missing-entry", Rule: "table",
+		Summary:   "A code block or frontmatter array has a key that the matched table does not list.",
+		Rationale: "Options shown in examples but missing from the reference table are undocumented.",
+		Example:   "tables:\n  - match_keys: {column: Name, frontmatter: options}",
+		Fix:       "Add a table row for the key, or remove it from the example.",
+	},
+	{
+		Code: CodeTableKeysNotArray, Name: "table-keys-not-array", Rule: "table",
+		Summary:   "The frontmatter field a table is matched against is not an array.",
+		Rationale: "Table rows can only be compared with a list of keys; any other value would flag every row.",
+		Example:   "tables:\n  - match_keys: {column: Name, frontmatter: options}",
+		Fix:       "Make the frontmatter field a list, e.g. options: [timeout, retries].",
+	},
+	{
+		Code: CodeMultipleH1, Name: "multiple-h1", Rule: "heading",
+		Summary:   "The document has more than one level-1 heading.",
+		Rationale: "A single h1 is the document title; more than one confuses navigation and SEO.",
+		Example:   "heading_rules:\n  single_h1: true\n\n# Title\n# Another Title",
+		Fix:       "Demote the extra h1 headings to h2.",
+	},
+	{
+		Code: CodeSkippedHeadingLevel, Name: "skipped-heading-level", Rule: "heading",
+		Summary:   "A heading is more than one level deeper than the previous heading.",
+		Rationale: "Skipped levels break the outline used by screen readers and tables of contents.",
+		Example:   "heading_rules:\n  no_skip_levels: true\n\n# Title\n### Details",
+		Fix:       "Use the next level down (## after #) or add the missing intermediate heading.",
+	},
+	{
+		Code: CodeDuplicateHeading, Name: "duplicate-heading", Rule: "heading",
+		Summary:   "Two headings in the document have the same text.",
+		Rationale: "Duplicate headings produce ambiguous anchors and a confusing outline.",
+		Example:   "heading_rules:\n  unique: true\n\n## Example\n## Example",
+		Fix:       "Make the heading text unique, e.g. by naming what each example shows.",
+	},
+	{
+		Code: CodeDuplicateHeadingInLevel, Name: "duplicate-heading-in-level", Rule: "heading",
+		Summary:   "Two headings at the same level have the same text.",
+		Rationale: "Sibling-level duplicates are usually copy-paste mistakes.",
+		Example:   "heading_rules:\n  unique_per_level: true\n\n## Setup\n## Setup",
+		Fix:       "Rename one of the headings.",
+	},
+	{
+		Code: CodeHeadingTooDeep, Name: "heading-too-deep", Rule: "heading",
+		Summary:   "A heading is deeper than the configured maximum depth.",
+		Rationale: "Deeply nested outlines are hard to follow; split the document instead.",
+		Example:   "heading_rules:\n  max_depth: 3\n\n#### Too deep",
+		Fix:       "Flatten the outline or move the content to its own document.",
+	},
+	{
+		Code: CodeHeadingCase, Name: "heading-case", Rule: "heading",
+		Summary:   "A heading does not follow the configured capitalization style.",
+		Rationale: "Consistent capitalization makes documentation look deliberate and polished.",
+		Example:   "heading_rules:\n  case: sentence\n\n## Getting Started",
+		Fix:       "Capitalize the heading as the message describes, or list proper nouns under `acronyms`.",
+	},
+	{
+		Code: CodeHeadingTrailingPunctuation, Name: "heading-trailing-punctuation", Rule: "heading",
+		Summary:   "A heading ends with punctuation.",
+		Rationale: "Headings are labels, not sentences.",
+		Example:   "heading_rules:\n  no_trailing_punctuation: true\n\n## Installation:",
+		Fix:       "Remove the trailing punctuation.",
+	},
+	{
+		Code: CodeHeadingTooLong, Name: "heading-too-long", Rule: "heading",
+		Summary:   "A heading is longer than the configured maximum length.",
+		Rationale: "Long headings are hard to scan and produce unwieldy anchors.",
+		Example:   "heading_rules:\n  max_length: 60",
+		Fix:       "Shorten the heading and move detail into the section body.",
+	},
+	{
+		Code: CodeHeadingEmphasis, Name: "heading-emphasis", Rule: "heading",
+		Summary:   "A heading contains emphasis.",
+		Rationale: "Headings are already emphasized; extra styling adds noise.",
+		Example:   "heading_rules:\n  no_emphasis: true\n\n## The **best** way",
+		Fix:       "Remove the * or _ markers from the heading.",
+	},
+	{
+		Code: CodeHeadingCode, Name: "heading-code", Rule: "heading",
+		Summary:   "A heading contains inline code.",
+		Rationale: "Code spans in headings render inconsistently and complicate anchors.",
+		Example:   "heading_rules:\n  no_code: true\n\n## The `run` command",
+		Fix:       "Remove the backticks from the heading.",
+	},
+	{
+		Code: CodeBrokenAnchor, Name: "broken-anchor", Rule: "link",
+		Summary:   "An anchor link points to a heading that does not exist in the document.",
+		Rationale: "Broken anchors silently drop readers at the top of the page.",
+		Example:   "links:\n  validate_internal: true\n\n[see usage](#usgae)",
+		Fix:       "Point the link at an existing heading's slug, or fix the heading it refers to.",
+	},
+	{
+		Code: CodeBrokenFileLink, Name: "broken-file-link", Rule: "link",
+		Summary:   "A relative link points to a file that does not exist.",
+		Rationale: "Files get moved and renamed; links to them should move too.",
+		Example:   "links:\n  validate_files: true\n\n[guide](./docs/gude.md)",
+		Fix:       "Correct the path (relative to the document, or to the schema directory for /paths).",
+	},
+	{
+		Code: CodeBlockedDomain, Name: "blocked-domain", Rule: "link",
+		Summary:   "A link points to a blocked domain.",
+		Rationale: "Some domains are internal, deprecated, or otherwise not for publication.",
+		Example:   "links:\n  blocked_domains: [internal.example.com]",
+		Fix:       "Link to a public or approved source instead.",
+	},
+	{
+		Code: CodeDomainNotAllowed, Name: "domain-not-allowed", Rule: "link",
+		Summary:   "A link points to a domain that is not in the allowed list.",
+		Rationale: "Restricting external links keeps documentation pointing at trusted sources.",
+		Example:   "links:\n  allowed_domains: [github.com, go.dev]",
+		Fix:       "Link to an allowed domain, or add the domain to `allowed_domains`.",
+	},
+	{
+		Code: CodeInvalidURL, Name: "invalid-url", Rule: "link",
+		Summary:   "An external link is not a valid URL.",
+		Rationale: "Malformed URLs cannot be followed.",
+		Example:   "[docs](https://exa mple.com)",
+		Fix:       "Fix the URL syntax, percent-encoding characters such as spaces.",
+	},
+	{
+		Code: CodeUnreachableURL, Name: "unreachable-url", Rule: "link",
+		Summary:   "An external URL could not be reached.",
+		Rationale: "Dead links frustrate readers.",
+		Example:   "links:\n  validate_external: true\n\n[docs](https://no-such-host.example)",
+		Fix:       "Update or remove the link; raise `external_timeout` if the host is slow.",
+	},
+	{
+		Code: CodeURLErrorStatus, Name: "url-error-status", Rule: "link",
+		Summary:   "An external URL responded with an HTTP error status (4xx or 5xx).",
+		Rationale: "Pages that return errors have usually moved or been removed.",
+		Example:   "links:\n  validate_external: true\n\n[docs](https://example.com/removed-page)",
+		Fix:       "Link to the page's new location, or remove the link.",
+	},
+	{
+		Code: CodeForbiddenLinkText, Name: "forbidden-link-text", Rule: "link",
+		Summary:   "A link's text is one of the texts listed in links.forbidden_text.",
+		Rationale: "Link text like \"click here\" says nothing about the target, which hurts screen reader users who navigate by links.",
+		Example:   "links:\n  forbidden_text: [click here, here]\n\nFor details [click here](./setup.md).",
+		Fix:       "Describe the target in the link text: see the [setup guide](./setup.md).",
+	},
+	{
+		Code: CodeURLAsLinkText, Name: "url-as-link-text", Rule: "link",
+		Summary:   "A link's text is a bare URL.",
+		Rationale: "URLs are long and hard to read aloud; descriptive text tells the reader where the link goes.",
+		Example:   "links:\n  no_url_text: true\n\n[https://example.com/docs](https://example.com/docs)",
+		Fix:       "Replace the URL with a description: [the documentation](https://example.com/docs).",
+	},
+	{
+		Code: CodeInsecureLink, Name: "insecure-link", Rule: "link",
+		Summary:   "A link uses http:// while links.require_https is set.",
+		Rationale: "Plain HTTP links can be intercepted and are often redirected anyway.",
+		Example:   "links:\n  require_https: true\n\n[site](http://example.com)",
+		Fix:       "Use the https:// URL.",
+	},
+	{
+		Code: CodeDisallowedScheme, Name: "disallowed-url-scheme", Rule: "link",
+		Summary:   "A link uses a URL scheme that is not in links.allowed_schemes.",
+		Rationale: "Schemes such as javascript: or file: do not work for readers and can be unsafe.",
+		Example:   "links:\n  allowed_schemes: [mailto, tel]\n\n[open](file:///tmp/report.html)",
+		Fix:       "Link to an http(s) URL or a relative path, or add the scheme to allowed_schemes.",
+	},
+	{
+		Code: CodePreferRelativeLink, Name: "prefer-relative-link", Rule: "link",
+		Summary:   "A link points at a prefer_relative_for domain with an absolute URL.",
+		Rationale: "Relative links to our own docs keep working in previews, forks, and versioned copies of the site.",
+		Example:   "links:\n  prefer_relative_for: [docs.example.com]\n\n[setup](https://docs.example.com/setup)",
+		Fix:       "Link to the page with a relative path, e.g. [setup](./setup.md).",
+	},
+	{
+		Code: CodeMissingFrontmatter, Name: "missing-frontmatter", Rule: "frontmatter",
+		Summary:   "The document has no frontmatter block but the schema requires one.",
+		Rationale: "Static site generators and indexes read metadata from frontmatter.",
+		Example:   "frontmatter:\n  fields:\n    - {name: title}",
+		Fix:       "Add a frontmatter block at the top of the document (--- YAML, +++ TOML, or JSON), or set `optional: true`.",
+	},
+	{
+		Code: CodeInvalidFrontmatter, Name: "invalid-frontmatter", Rule: "frontmatter",
+		Summary:   "The frontmatter block could not be parsed.",
+		Rationale: "Unparseable metadata is ignored or breaks the build downstream.",
+		Example:   "---\ntitle: [unclosed\n---",
+		Fix:       "Fix the frontmatter syntax.",
+	},
+	{
+		Code: CodeMissingField, Name: "missing-field", Rule: "frontmatter",
+		Summary:   "A required frontmatter field is missing.",
+		Rationale: "Required metadata such as title or date is needed by publishing tools.",
+		Example:   "frontmatter:\n  fields:\n    - {name: date}",
+		Fix:       "Add the field to the frontmatter, or mark it `optional: true`.",
+	},
+	{
+		Code: CodeWrongFieldType, Name: "wrong-field-type", Rule: "frontmatter",
+		Summary:   "A frontmatter field has the wrong type.",
+		Rationale: "Consumers of the metadata expect a specific type, e.g. a list of tags.",
+		Example:   "frontmatter:\n  fields:\n    - {name: tags, type: array}\n\n---\ntags: go\n---",
+		Fix:       "Change the value to the declared type (e.g. tags: [go]).",
+	},
+	{
+		Code: CodeWrongFieldFormat, Name: "wrong-field-format", Rule: "frontmatter",
+		Summary:   "A frontmatter field does not match its declared format.",
+		Rationale: "Dates, emails and URLs must be machine-readable.",
+		Example:   "frontmatter:\n  fields:\n    - {name: date, format: date}\n\n---\ndate: March 3rd\n---",
+		Fix:       "Write the value in the declared format (date: YYYY-MM-DD, email, or http(s) URL).",
+	},
+	{
+		Code: CodeValueNotAllowed, Name: "value-not-allowed", Rule: "frontmatter",
+		Summary:   "A frontmatter value is not one of the allowed values.",
+		Rationale: "Fields like status or category drive behavior and must use known values.",
+		Example:   "frontmatter:\n  fields:\n    - {name: status, enum: [draft, published]}\n\n---\nstatus: wip\n---",
+		Fix:       "Use one of the values listed in the message, or extend the field's `enum`.",
+	},
+	{
+		Code: CodeFieldPatternMismatch, Name: "field-pattern-mismatch", Rule: "frontmatter",
+		Summary:   "A frontmatter string does not match the field's pattern.",
+		Rationale: "Identifiers such as versions or slugs are parsed by tooling and must follow one format.",
+		Example:   "frontmatter:\n  fields:\n    - {name: version, pattern: '^v\\d+\\.\\d+$'}\n\n---\nversion: 1.2\n---",
+		Fix:       "Rewrite the value to match the pattern.",
+	},
+	{
+		Code: CodeFieldTooShort, Name: "field-too-short", Rule: "frontmatter",
+		Summary:   "A frontmatter string is shorter than min_length.",
+		Rationale: "Very short descriptions and summaries are usually placeholders.",
+		Example:   "frontmatter:\n  fields:\n    - {name: description, min_length: 50}",
+		Fix:       "Expand the value to at least min_length characters.",
+	},
+	{
+		Code: CodeFieldTooLong, Name: "field-too-long", Rule: "frontmatter",
+		Summary:   "A frontmatter string is longer than max_length.",
+		Rationale: "Titles and descriptions are truncated by search engines and link previews.",
+		Example:   "frontmatter:\n  fields:\n    - {name: description, max_length: 160}",
+		Fix:       "Shorten the value to at most max_length characters.",
+	},
+	{
+		Code: CodeFieldBelowMinimum, Name: "field-below-minimum", Rule: "frontmatter",
+		Summary:   "A frontmatter number is below the field's minimum.",
+		Rationale: "Values such as weights or priorities are only meaningful within a range.",
+		Example:   "frontmatter:\n  fields:\n    - {name: weight, type: number, minimum: 0}",
+		Fix:       "Use a value of at least the minimum.",
+	},
+	{
+		Code: CodeFieldAboveMaximum, Name: "field-above-maximum", Rule: "frontmatter",
+		Summary:   "A frontmatter number is above the field's maximum.",
+		Rationale: "Values such as weights or priorities are only meaningful within a range.",
+		Example:   "frontmatter:\n  fields:\n    - {name: priority, type: number, maximum: 5}",
+		Fix:       "Use a value of at most the maximum.",
+	},
+	{
+		Code: CodeTooFewFieldItems, Name: "too-few-field-items", Rule: "frontmatter",
+		Summary:   "A frontmatter array has fewer elements than min_items.",
+		Rationale: "Lists such as tags or authors are expected to be filled in.",
+		Example:   "frontmatter:\n  fields:\n    - {name: tags, type: array, min_items: 1}",
+		Fix:       "Add elements to the array.",
+	},
+	{
+		Code: CodeTooManyFieldItems, Name: "too-many-field-items", Rule: "frontmatter",
+		Summary:   "A frontmatter array has more elements than max_items.",
+		Rationale: "Long tag lists dilute navigation and search.",
+		Example:   "frontmatter:\n  fields:\n    - {name: tags, type: array, max_items: 5}",
+		Fix:       "Remove elements from the array.",
+	},
+	{
+		Code: CodeDuplicateFieldItems, Name: "duplicate-field-items", Rule: "frontmatter",
+		Summary:   "A frontmatter array with unique_items contains the same element twice.",
+		Rationale: "Duplicates are usually copy-paste mistakes and show up twice on generated pages.",
+		Example:   "frontmatter:\n  fields:\n    - {name: tags, type: array, unique_items: true}\n\n---\ntags: [go, go]\n---",
+		Fix:       "Remove the duplicate element.",
+	},
+	{
+		Code: CodeInvalidFieldItem, Name: "invalid-field-item", Rule: "frontmatter",
+		Summary:   "A frontmatter array element does not match the field's items type, enum, or pattern.",
+		Rationale: "Tooling that reads array fields expects every element in the same shape.",
+		Example:   "frontmatter:\n  fields:\n    - name: tags\n      type: array\n      items: {type: string, pattern: '^[a-z-]+$'}",
+		Fix:       "Rewrite the element to match the items constraints.",
+	},
+	{
+		Code: CodeDeprecatedField, Name: "deprecated-field", Rule: "frontmatter",
+		Summary:   "The document uses a deprecated frontmatter field.",
+		Rationale: "Deprecated fields are ignored by newer tooling and will eventually be removed.",
+		Example:   "frontmatter:\n  fields:\n    - {name: author, deprecated: true, replaced_by: authors}",
+		Fix:       "Move the value to the replacement field named in the message, or remove the field.",
+	},
+	{
+		Code: CodeJSONSchemaViolation, Name: "json-schema-violation", Rule: "frontmatter",
+		Summary:   "The frontmatter does not validate against the configured JSON Schema.",
+		Rationale: "Static-site generators publish JSON Schemas for page frontmatter; validating against them catches errors before the site build does.",
+		Example:   "frontmatter:\n  json_schema: ./page.schema.json",
+		Fix:       "Change the value at the reported key so it satisfies the schema keyword named in the message.",
+	},
+	{
+		Code: CodeInvalidJSONSchema, Name: "invalid-json-schema", Rule: "frontmatter",
+		Summary:   "The frontmatter JSON Schema file could not be read, parsed, or uses an unsupported keyword.",
+		Rationale: "Without a readable schema the frontmatter cannot be validated at all, and skipping a keyword would silently accept invalid values.",
+		Example:   "frontmatter:\n  json_schema: ./missing.schema.json",
+		Fix:       "Fix the json_schema path (relative to the schema file) or the JSON syntax of the schema. Replace unevaluatedProperties/unevaluatedItems with additionalProperties/items, and $dynamicRef/$recursiveRef with $ref.",
+	},
+	{
+		Code: CodeUnknownField, Name: "unknown-field", Rule: "frontmatter",
+		Summary:   "A frontmatter key is not declared in a closed frontmatter schema.",
+		Rationale: "Typos like 'tittle' otherwise pass silently while the intended field is ignored.",
+		Example:   "frontmatter:\n  additional_fields: false\n  fields:\n    - { name: title }\n\n---\ntittle: Hello\n---",
+		Fix:       "Rename the key to a declared field, declare it, or allow it with allowed_fields.",
+	},
+	{
+		Code: CodeAssertionFailed, Name: "assertion-failed", Rule: "frontmatter",
+		Summary:   "A cross-field frontmatter assertion evaluated to false.",
+		Rationale: "Some constraints span several fields, such as an update date that must not precede the publish date.",
+		Example:   "frontmatter:\n  assertions:\n    - expr: \"updated >= date\"\n      message: updated must be on or after date",
+		Fix:       "Change the frontmatter values so that the assertion holds.",
+	},
+	{
+		Code: CodeInvalidAssertion, Name: "invalid-assertion", Rule: "frontmatter",
+		Summary:   "A frontmatter assertion is not a valid boolean expression or failed to evaluate.",
+		Rationale: "An assertion that cannot be evaluated never checks anything.",
+		Example:   "frontmatter:\n  assertions:\n    - expr: \"updated >=\"",
+		Fix:       "Fix the expression syntax, or guard fields that may be missing (e.g. 'updated == nil || updated >= date').",
+	},
+	{
+		Code: CodeWrongFrontmatterFormat, Name: "wrong-frontmatter-format", Rule: "frontmatter",
+		Summary:   "The frontmatter block is not in the format the schema requires.",
+		Rationale: "Site generators and tooling often read only one frontmatter format, so mixing formats breaks them.",
+		Example:   "frontmatter:\n  format: toml\n\n---\ntitle: Hello\n---",
+		Fix:       "Convert the block to the required format (--- YAML, +++ TOML, or ;;; JSON).",
+	},
+	{
+		Code: CodeContentMismatch, Name: "frontmatter-content-mismatch", Rule: "frontmatter-body",
+		Summary:   "A frontmatter field does not repeat the document content it should match.",
+		Rationale: "Titles and descriptions duplicated in frontmatter drift from the H1 and lead paragraph when only one is edited.",
+		Example:   "frontmatter:\n  fields:\n    - { name: title, matches: h1 }\n\n---\ntitle: Install guide\n---\n\n# Installation",
+		Fix:       "Update the frontmatter value or the document content so they are identical.",
+	},
+	{
+		Code: CodeDiscouragedTerm, Name: "discouraged-term", Rule: "terminology",
+		Summary:   "The document uses a term the schema lists as discouraged.",
+		Rationale: "Consistent, inclusive terminology reads better and avoids confusing synonyms.",
+		Example:   "terminology:\n  terms:\n    whitelist: allowlist\n    e-mail: email",
+		Fix:       "Replace the term with the suggested one.",
+	},
+}
+
+// Codes returns documentation for every code, in code order
+func Codes() []CodeInfo {
+	infos := make([]CodeInfo, len(codeInfos))
+	copy(infos, codeInfos)
+	sort.Slice(infos, func(i, j int) bool { return infos[i].Code < infos[j].Code })
+	return infos
+}
+
+// LookupCode finds a code by its identifier (e.g. "MDS102") or its name
+// (e.g. "out-of-order"), ignoring case
+func LookupCode(s string) (CodeInfo, bool) {
+	for _, info := range codeInfos {
+		if strings.EqualFold(string(info.Code), s) || strings.EqualFold(info.Name, s) {
+			return info, true
+		}
+	}
+	return CodeInfo{}, false
+}
@@ -0,0 +1,179 @@
+package rules
+
+import (
+	"regexp"
+	"slices"
+	"testing"
+)
+
+func TestCodesAreDocumented(t *testing.T) {
+	codePattern := regexp.MustCompile(`^MDS[1-6]\d\d$`)
+	namePattern := regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+	seenCodes := make(map[Code]bool)
+	seenNames := make(map[string]bool)
+
+	for _, info := range Codes() {
+		if !codePattern.MatchString(string(info.Code)) {
+			t.Errorf("code %q does not match MDSnnn", info.Code)
+		}
+		if !namePattern.MatchString(info.Name) {
+			t.Errorf("%s: name %q is not kebab-case", info.Code, info.Name)
+		}
+		if seenCodes[info.Code] || seenNames[info.Name] {
+			t.Errorf("%s %s: duplicate code or name", info.Code, info.Name)
+		}
+		seenCodes[info.Code] = true
+		seenNames[info.Name] = true
+
+		if !slices.Contains(RuleNames(), info.Rule) {
+			t.Errorf("%s: unknown rule %q", info.Code, info.Rule)
+		}
+		if info.Summary == "" || info.Rationale == "" || info.Example == "" || info.Fix == "" {
+			t.Errorf("%s: missing documentation: %+v", info.Code, info)
+		}
+	}
+}
+
+func TestLookupCode(t *testing.T) {
+	tests := []struct {
+		query string
+		want  Code
+		found bool
+	}{
+		{"MDS102", CodeOutOfOrder, true},
+		{"mds102", CodeOutOfOrder, true},
+		{"out-of-order", CodeOutOfOrder, true},
+		{"MDS999", "", false},
+		{"structure", "", false},
+	}
+
+	for _, tt := range tests {
+		info, ok := LookupCode(tt.query)
+		if ok != tt.found || info.Code != tt.want {
+			t.Errorf("LookupCode(%q) = %q, %v; want %q, %v", tt.query, info.Code, ok, tt.want, tt.found)
+		}
+	}
+}
+
+// frozenCodes pins every published code to its name. Codes may be added,
+// but an existing entry must never change or disappear.
+var frozenCodes = map[Code]string{
+	"MDS101": "missing-section",
+	"MDS102": "out-of-order",
+	"MDS103": "unexpected-section",
+	"MDS104": "too-few-occurrences",
+	"MDS105": "too-many-occurrences",
+	"MDS106": "wrong-first-heading",
+	"MDS107": "heading-expr-error",
+	"MDS201": "missing-required-text",
+	"MDS202": "forbidden-text",
+	"MDS211": "too-few-code-blocks",
+	"MDS212": "too-many-code-blocks",
+	"MDS213": "invalid-code-syntax",
+	"MDS214": "missing-code-text",
+	"MDS215": "forbidden-code-text",
+	"MDS216": "code-block-too-short",
+	"MDS217": "code-block-too-long",
+	"MDS221": "too-few-images",
+	"MDS222": "too-many-images",
+	"MDS223": "missing-image-alt",
+	"MDS224": "missing-image-file",
+	"MDS225": "image-file-too-large",
+	"MDS226": "image-dimensions-too-large",
+	"MDS227": "short-image-alt",
+	"MDS228": "uninformative-image-alt",
+	"MDS229": "unreadable-image-file",
+	"MDS231": "too-few-tables",
+	"MDS232": "too-many-tables",
+	"MDS233": "too-few-table-columns",
+	"MDS234": "missing-table-header",
+	"MDS235": "too-few-table-rows",
+	"MDS236": "too-many-table-rows",
+	"MDS237": "table-headers-out-of-order",
+	"MDS238": "unexpected-table-header",
+	"MDS241": "too-few-lists",
+	"MDS242": "too-many-lists",
+	"MDS243": "too-few-list-items",
+	"MDS244": "too-many-list-items",
+	"MDS245": "invalid-list-item",
+	"MDS246": "list-too-deep",
+	"MDS247": "unchecked-task",
+	"MDS248": "checked-task",
+	"MDS251": "too-few-words",
+	"MDS252": "too-many-words",
+	"MDS261": "too-few-paragraphs",
+	"MDS262": "too-many-paragraphs",
+	"MDS271": "missing-code-lang",
+	"MDS272": "unknown-code-language",
+	"MDS273": "missing-code-attribute",
+	"MDS274": "unexpected-code-attribute",
+	"MDS281": "empty-table-cell",
+	"MDS282": "invalid-table-cell",
+	"MDS283": "table-extra-entry",
+	"MDS284": "table-missing-entry",
+	"MDS285": "table-keys-not-array",
+	"MDS301": "multiple-h1",
+	"MDS302": "skipped-heading-level",
+	"MDS303": "duplicate-heading",
+	"MDS304": "duplicate-heading-in-level",
+	"MDS305": "heading-too-deep",
+	"MDS306": "heading-case",
+	"MDS307": "heading-trailing-punctuation",
+	"MDS308": "heading-too-long",
+	"MDS309": "heading-emphasis",
+	"MDS310": "heading-code",
+	"MDS401": "broken-anchor",
+	"MDS402": "broken-file-link",
+	"MDS403": "blocked-domain",
+	"MDS404": "domain-not-allowed",
+	"MDS405": "invalid-url",
+	"MDS406": "unreachable-url",
+	"MDS407": "url-error-status",
+	"MDS408": "forbidden-link-text",
+	"MDS409": "url-as-link-text",
+	"MDS410": "insecure-link",
+	"MDS411": "disallowed-url-scheme",
+	"MDS412": "prefer-relative-link",
+	"MDS501": "missing-frontmatter",
+	"MDS502": "invalid-frontmatter",
+	"MDS503": "missing-field",
+	"MDS504": "wrong-field-type",
+	"MDS505": "wrong-field-format",
+	"MDS506": "value-not-allowed",
+	"MDS507": "field-pattern-mismatch",
+	"MDS508": "field-too-short",
+	"MDS509": "field-too-long",
+	"MDS510": "field-below-minimum",
+	"MDS511": "field-above-maximum",
+	"MDS512": "too-few-field-items",
+	"MDS513": "too-many-field-items",
+	"MDS514": "duplicate-field-items",
+	"MDS515": "invalid-field-item",
+	"MDS516": "deprecated-field",
+	"MDS517": "json-schema-violation",
+	"MDS518": "invalid-json-schema",
+	"MDS519": "unknown-field",
+	"MDS520": "assertion-failed",
+	"MDS521": "invalid-assertion",
+	"MDS522": "wrong-frontmatter-format",
+	"MDS523": "frontmatter-content-mismatch",
+	"MDS601": "discouraged-term",
+}
+
+func TestCodesAreFrozen(t *testing.T) {
+	for code, name := range frozenCodes {
+		info, ok := LookupCode(string(code))
+		if !ok {
+			t.Errorf("%s (%s) was removed", code, name)
+			continue
+		}
+		if info.Name != name {
+			t.Errorf("%s was renamed from %q to %q", code, name, info.Name)
+		}
+	}
+	for _, info := range Codes() {
+		if _, ok := frozenCodes[info.Code]; !ok {
+			t.Errorf("%s (%s) is not in frozenCodes; add it", info.Code, info.Name)
+		}
+	}
+}
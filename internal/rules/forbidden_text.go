@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
@@ -33,14 +34,17 @@ func (r *ForbiddenTextRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	ctx.Tree.WalkBound(func(n *vast.Node) bool {
 		if n.Element.SectionRules != nil && len(n.Element.ForbiddenText) > 0 {
 			for _, pattern := range n.Element.ForbiddenText {
-				if r.contentContainsPattern(n.Content(), pattern) {
-					patternStr := pattern.Literal
-					if patternStr == "" {
-						patternStr = pattern.Pattern
-					}
-					line, col := n.Location()
+				patternStr := pattern.Literal
+				if patternStr == "" {
+					patternStr = pattern.Pattern
+				}
+				opts := sectionReportOptions(pattern.Severity, pattern.Message, pattern.HelpURL, n.Element)
+				data := MessageData{Heading: n.HeadingText(), Expected: patternStr}
+				// Report every occurrence where it appears in the section
+				for _, offset := range indexAllTextPattern(n.Content(), pattern.Literal, pattern.Pattern) {
+					line, col := sectionPosition(n.Section, offset)
 					violations = append(violations,
-						NewViolation(r.Name(), fmt.Sprintf("Forbidden text '%s' found in section '%s'", patternStr, n.HeadingText()), line, col))
+						opts.apply(NewViolation(r.Name(), fmt.Sprintf("Forbidden text '%s' found in section '%s'", patternStr, n.HeadingText()), line, col).WithCode(CodeForbiddenText), data))
 				}
 			}
 		}
@@ -70,18 +74,46 @@ func (r *ForbiddenTextRule) GenerateContent(builder *strings.Builder, element sc
 	return true
 }
 
-// contentContainsPattern checks if content contains the forbidden pattern
-func (r *ForbiddenTextRule) contentContainsPattern(content string, pattern schema.ForbiddenTextPattern) bool {
-	// If literal is set (scalar form), use substring match
-	if pattern.Literal != "" {
-		return strings.Contains(content, pattern.Literal)
+// indexAllTextPattern returns the offsets of every non-overlapping match of a
+// literal (substring) or regex pattern in content. Empty matches (from
+// patterns like "a*" or "^") are skipped; they match at every offset.
+func indexAllTextPattern(content, literal, pattern string) []int {
+	offsets := make([]int, 0)
+	if literal == "" {
+		re, err := regexp.Compile(pattern)
+		if err == nil {
+			for _, loc := range re.FindAllStringIndex(content, -1) {
+				if loc[0] == loc[1] {
+					continue
+				}
+				offsets = append(offsets, loc[0])
+			}
+			return offsets
+		}
+		// If regex compilation fails, fall back to substring match
+		literal = pattern
 	}
+	for start := 0; ; {
+		idx := strings.Index(content[start:], literal)
+		if idx < 0 {
+			return offsets
+		}
+		offsets = append(offsets, start+idx)
+		start += idx + len(literal)
+	}
+}
 
-	// Otherwise use regex match
-	re, err := regexp.Compile(pattern.Pattern)
-	if err != nil {
-		// If regex compilation fails, fall back to substring match
-		return strings.Contains(content, pattern.Pattern)
+// sectionPosition converts a byte offset in a section's content to a
+// document line and column. Content starts on the line after the heading.
+func sectionPosition(section *parser.Section, offset int) (line, col int) {
+	line, col = offsetPosition(section.Content, offset)
+	return contentStartLine(section) + line - 1, col
+}
+
+// contentStartLine returns the document line of the first content line
+func contentStartLine(section *parser.Section) int {
+	if section.Heading != nil {
+		return section.StartLine + 1
 	}
-	return re.MatchString(content)
+	return section.StartLine
 }
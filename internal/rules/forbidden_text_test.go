@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
@@ -165,3 +166,78 @@ func TestForbiddenTextRuleGenerateContentNoRules(t *testing.T) {
 		t.Error("GenerateContent() should return false when no forbidden text rules")
 	}
 }
+
+func TestForbiddenTextRulePositions(t *testing.T) {
+	content := "# Title\n\nIntro.\n\n## Usage\n\nRun it. TODO: docs\n\nMore text, TODO and\nFIXME-1 here.\n"
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Pattern: "# Title"},
+				Children: []schema.StructureElement{
+					{
+						Heading: schema.HeadingPattern{Pattern: "## Usage"},
+						SectionRules: &schema.SectionRules{
+							ForbiddenText: []schema.ForbiddenTextPattern{
+								{Literal: "TODO"},
+								{Pattern: `FIXME-\d+`},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	violations := NewForbiddenTextRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	want := []string{"7:9 TODO", "9:12 TODO", "10:1 FIXME-\\d+"}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d: %+v", len(violations), len(want), violations)
+	}
+	for i, v := range violations {
+		if !strings.HasPrefix(want[i], fmt.Sprintf("%d:%d ", v.Line, v.Column)) ||
+			!strings.Contains(v.Message, strings.SplitN(want[i], " ", 2)[1]) {
+			t.Errorf("violation %d = %d:%d %q, want %s", i, v.Line, v.Column, v.Message, want[i])
+		}
+	}
+}
+
+func TestForbiddenTextRuleSkipsEmptyMatches(t *testing.T) {
+	doc, err := parser.New().Parse("test.md", []byte("# Title\n\nbaaad words\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		pattern string
+		want    int
+	}{
+		{"", 0},
+		{"^", 0},
+		{"x?", 0},
+		{"a*", 1}, // only "aaa", not the empty match at every other offset
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading: schema.HeadingPattern{Pattern: "# Title"},
+						SectionRules: &schema.SectionRules{
+							ForbiddenText: []schema.ForbiddenTextPattern{{Pattern: tt.pattern}},
+						},
+					},
+				},
+			}
+			violations := NewForbiddenTextRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != tt.want {
+				t.Errorf("got %d violations, want %d: %+v", len(violations), tt.want, violations)
+			}
+		})
+	}
+}
@@ -2,13 +2,20 @@ package rules
 
 import (
 	"fmt"
+	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
+	"sync"
 	"time"
+	"unicode/utf8"
 
+	"github.com/jackchuka/mdschema/internal/expression"
+	"github.com/jackchuka/mdschema/internal/jsonschema"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
+	"gopkg.in/yaml.v3"
 )
 
 // dateLayout is the only date form mdschema validates (see FieldFormatDate).
@@ -16,6 +23,14 @@ const dateLayout = "2006-01-02"
 
 // FrontmatterRule validates YAML frontmatter at the start of documents
 type FrontmatterRule struct {
+	mu          sync.Mutex
+	jsonSchemas map[string]compiledJSONSchema // JSON Schemas by resolved path
+}
+
+// compiledJSONSchema caches a loaded JSON Schema, or the error loading it
+type compiledJSONSchema struct {
+	validator *jsonschema.Validator
+	err       error
 }
 
 var _ Rule = (*FrontmatterRule)(nil)
@@ -23,7 +38,7 @@ var _ FrontmatterGenerator = (*FrontmatterRule)(nil)
 
 // NewFrontmatterRule creates a new frontmatter rule
 func NewFrontmatterRule() *FrontmatterRule {
-	return &FrontmatterRule{}
+	return &FrontmatterRule{jsonSchemas: make(map[string]compiledJSONSchema)}
 }
 
 // Name returns the rule identifier
@@ -42,11 +57,12 @@ func (r *FrontmatterRule) ValidateWithContext(ctx *vast.Context) []Violation {
 
 	config := ctx.Schema.Frontmatter
 	fm := ctx.Tree.Document.FrontMatter
+	opts := reportOptions{severity: severityFromSchema(config.Severity), message: config.Message, helpURL: config.HelpURL}
 
 	// Check if frontmatter is required but missing
 	if !config.Optional && fm == nil {
 		violations = append(violations,
-			NewViolation(r.Name(), "Frontmatter is required but not found", 1, 1))
+			opts.apply(NewViolation(r.Name(), "Frontmatter is required but not found", 1, 1).WithCode(CodeMissingFrontmatter), MessageData{}))
 		return violations
 	}
 
@@ -55,20 +71,39 @@ func (r *FrontmatterRule) ValidateWithContext(ctx *vast.Context) []Violation {
 		return violations
 	}
 
+	if config.Format != "" && fm.Format != config.Format {
+		violations = append(violations,
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter is %s, expected %s", strings.ToUpper(fm.Format), strings.ToUpper(config.Format)), 1, 1).WithCode(CodeWrongFrontmatterFormat),
+				MessageData{Found: fm.Format, Expected: config.Format}))
+	}
+
 	// If frontmatter exists but couldn't be parsed, report error
 	if fm.Data == nil {
 		violations = append(violations,
-			NewViolation(r.Name(), "Frontmatter could not be parsed as valid YAML", 1, 1))
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter could not be parsed as valid %s", strings.ToUpper(fm.Format)), 1, 1).WithCode(CodeInvalidFrontmatter), MessageData{}))
 		return violations
 	}
 
 	// Validate required fields
 	for _, field := range config.Fields {
 		value, exists := lookupField(fm.Data, field.Name)
+		fieldOpts := fieldReportOptions(field, config)
+		data := MessageData{Field: field.Name, Found: value}
+		keyNode, valueNode, parentKey := fm.Lookup(splitFieldPath(field.Name))
+		line, col := nodePosition(valueNode)
+
+		if field.Deprecated {
+			if exists {
+				violations = append(violations, r.deprecatedField(field, fieldOpts, data, keyNode))
+			}
+			continue
+		}
 
 		if !field.Optional && !exists {
+			// A missing nested field is reported at its closest existing parent
+			line, col := nodePosition(parentKey)
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Required frontmatter field '%s' is missing", field.Name), 1, 1))
+				fieldOpts.apply(NewViolation(r.Name(), fmt.Sprintf("Required frontmatter field '%s' is missing", field.Name), line, col).WithCode(CodeMissingField), data))
 			continue
 		}
 
@@ -80,8 +115,9 @@ func (r *FrontmatterRule) ValidateWithContext(ctx *vast.Context) []Violation {
 		wellFormed := true
 		if field.Type != "" {
 			if err := r.validateFieldType(field.Name, value, field.Type); err != "" {
+				data.Expected = string(field.Type)
 				violations = append(violations,
-					NewViolation(r.Name(), err, 1, 1))
+					fieldOpts.apply(NewViolation(r.Name(), err, line, col).WithCode(CodeWrongFieldType), data))
 				wellFormed = false
 			}
 		}
@@ -89,8 +125,9 @@ func (r *FrontmatterRule) ValidateWithContext(ctx *vast.Context) []Violation {
 		// Validate field format if specified
 		if field.Format != "" {
 			if err := r.validateFieldFormat(field.Name, value, field.Format); err != "" {
+				data.Expected = string(field.Format)
 				violations = append(violations,
-					NewViolation(r.Name(), err, 1, 1))
+					fieldOpts.apply(NewViolation(r.Name(), err, line, col).WithCode(CodeWrongFieldFormat), data))
 				wellFormed = false
 			}
 		}
@@ -100,16 +137,371 @@ func (r *FrontmatterRule) ValidateWithContext(ctx *vast.Context) []Violation {
 		// both leaves the reader with two violations for one mistake — the
 		// earlier, more specific message stands on its own.
 		if len(field.Enum) > 0 && wellFormed {
+			data.Expected = field.Enum
 			for _, err := range r.validateFieldEnum(field, value) {
+				line, col := line, col
+				if err.index >= 0 && valueNode != nil && valueNode.Kind == yaml.SequenceNode && err.index < len(valueNode.Content) {
+					line, col = nodePosition(valueNode.Content[err.index])
+				}
 				violations = append(violations,
-					NewViolation(r.Name(), err, 1, 1))
+					fieldOpts.apply(NewViolation(r.Name(), err.message, line, col).WithCode(CodeValueNotAllowed), data))
 			}
 		}
+
+		for _, err := range r.validateFieldConstraints(field, value) {
+			line, col := line, col
+			if err.index >= 0 && valueNode != nil && valueNode.Kind == yaml.SequenceNode && err.index < len(valueNode.Content) {
+				line, col = nodePosition(valueNode.Content[err.index])
+			}
+			data := data
+			data.Expected = err.expected
+			violations = append(violations,
+				fieldOpts.apply(NewViolation(r.Name(), err.message, line, col).WithCode(err.code), data))
+		}
+
+		if field.AdditionalFields != nil && !*field.AdditionalFields {
+			violations = append(violations,
+				r.validateAdditionalFields(valueNode, splitFieldPath(field.Name), config.Fields, field.AllowedFields, fieldOpts)...)
+		}
+	}
+
+	if config.AdditionalFields != nil && !*config.AdditionalFields {
+		violations = append(violations, r.validateAdditionalFields(fm.Node, nil, config.Fields, config.AllowedFields, opts)...)
+	}
+
+	if config.JSONSchema != "" {
+		violations = append(violations, r.validateJSONSchema(ctx, config.JSONSchema, opts)...)
+	}
+
+	for _, assertion := range config.Assertions {
+		if v, ok := r.validateAssertion(assertion, config, fm.Data); !ok {
+			violations = append(violations, v)
+		}
 	}
 
 	return violations
 }
 
+// validateAssertion evaluates a cross-field assertion and returns a violation
+// at the start of the frontmatter when it is false or cannot be evaluated
+func (r *FrontmatterRule) validateAssertion(assertion schema.FrontmatterAssertion, config *schema.FrontmatterConfig, data map[string]any) (Violation, bool) {
+	helpURL := assertion.HelpURL
+	if helpURL == "" {
+		helpURL = config.HelpURL
+	}
+	opts := reportOptions{severity: ruleSeverity(assertion.Severity, config.Severity), message: assertion.Message, helpURL: helpURL}
+	msgData := MessageData{Expected: assertion.Expr}
+
+	ok, err := expression.EvalAssertion(assertion.Expr, data)
+	if err != nil {
+		// Evaluation errors point at the schema, so they keep the generic message
+		opts.message = ""
+		return opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter assertion '%s' could not be evaluated: %v", assertion.Expr, err), 1, 1).WithCode(CodeInvalidAssertion), msgData), false
+	}
+	if !ok {
+		return opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter assertion failed: %s", assertion.Expr), 1, 1).WithCode(CodeAssertionFailed), msgData), false
+	}
+	return Violation{}, true
+}
+
+// validateAdditionalFields reports keys of a mapping node that are neither
+// declared as fields under prefix nor matched by an allowed pattern. Each
+// unknown key is reported at its position with the closest declared name as
+// a suggestion.
+func (r *FrontmatterRule) validateAdditionalFields(node *yaml.Node, prefix []string, fields []schema.FrontmatterField, allowed []string, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	if node == nil || node.Kind != yaml.MappingNode {
+		return violations
+	}
+
+	declared := declaredFieldNames(fields, prefix)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		keyNode := node.Content[i]
+		key := keyNode.Value
+		if slices.Contains(declared, key) || matchesFieldPattern(key, allowed) {
+			continue
+		}
+
+		name := joinFieldPath(append(slices.Clone(prefix), key))
+		message := fmt.Sprintf("Unknown frontmatter field '%s'", name)
+		data := MessageData{Field: name, Found: key}
+		if suggestion := nearestFieldName(key, declared); suggestion != "" {
+			message = fmt.Sprintf("Unknown frontmatter field '%s', did you mean '%s'?", name, suggestion)
+			data.Expected = suggestion
+		}
+		line, col := nodePosition(keyNode)
+		violations = append(violations,
+			opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeUnknownField), data))
+	}
+	return violations
+}
+
+// declaredFieldNames returns the distinct keys declared directly below
+// prefix, e.g. "author" for a field named "metadata.author.name" under
+// ["metadata"]
+func declaredFieldNames(fields []schema.FrontmatterField, prefix []string) []string {
+	var names []string
+	for _, field := range fields {
+		segments := splitFieldPath(field.Name)
+		if len(segments) <= len(prefix) || !slices.Equal(segments[:len(prefix)], prefix) {
+			continue
+		}
+		if name := segments[len(prefix)]; !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// matchesFieldPattern reports whether a key fully matches one of the allowed
+// patterns. An invalid regex only matches the key literally.
+func matchesFieldPattern(key string, patterns []string) bool {
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			if key == pattern {
+				return true
+			}
+			continue
+		}
+		if re.MatchString(key) {
+			return true
+		}
+	}
+	return false
+}
+
+// joinFieldPath is the inverse of splitFieldPath, escaping literal dots
+func joinFieldPath(segments []string) string {
+	escaped := make([]string, len(segments))
+	for i, seg := range segments {
+		escaped[i] = strings.ReplaceAll(seg, ".", `\.`)
+	}
+	return strings.Join(escaped, ".")
+}
+
+// nearestFieldName returns the candidate closest to key by edit distance,
+// ignoring case, or "" when none is within a third of the key's length
+func nearestFieldName(key string, candidates []string) string {
+	best, bestDistance := "", max(1, utf8.RuneCountInString(key)/3)+1
+	for _, candidate := range candidates {
+		if d := editDistance(strings.ToLower(key), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+// editDistance returns the Levenshtein distance between two strings
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+// validateJSONSchema validates the frontmatter against a JSON Schema file,
+// resolved relative to the schema directory. Errors about an object's keys
+// (such as required) are reported at the object's key, all others at the
+// offending value.
+func (r *FrontmatterRule) validateJSONSchema(ctx *vast.Context, path string, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	fm := ctx.Tree.Document.FrontMatter
+
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(ctx.RootDir, path)
+	}
+	validator, err := r.compileJSONSchema(path)
+	if err != nil {
+		return append(violations, opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter JSON Schema could not be loaded: %v", err), 1, 1).WithCode(CodeInvalidJSONSchema), MessageData{Expected: path}))
+	}
+
+	for _, e := range validator.Validate(fm.Data) {
+		key, value, parent := fm.Lookup(e.Path)
+		line, col := nodePosition(value)
+		switch {
+		case key == nil:
+			line, col = nodePosition(parent)
+		case e.Keyword == "required" || e.Keyword == "dependentRequired" || e.Keyword == "additionalProperties" || e.Keyword == "propertyNames":
+			line, col = nodePosition(key)
+		}
+
+		field := strings.Join(e.Path, ".")
+		message := "Frontmatter " + e.Message
+		if field != "" {
+			message = fmt.Sprintf("Frontmatter field '%s' %s", field, e.Message)
+		}
+		data := MessageData{Field: field, Expected: e.Keyword}
+		if value != nil {
+			data.Found = value.Value
+		}
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeJSONSchemaViolation), data))
+	}
+
+	return violations
+}
+
+// compileJSONSchema loads a JSON Schema once per path
+func (r *FrontmatterRule) compileJSONSchema(path string) (*jsonschema.Validator, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.jsonSchemas == nil {
+		r.jsonSchemas = make(map[string]compiledJSONSchema)
+	}
+	if compiled, ok := r.jsonSchemas[path]; ok {
+		return compiled.validator, compiled.err
+	}
+	validator, err := jsonschema.Compile(path)
+	r.jsonSchemas[path] = compiledJSONSchema{validator: validator, err: err}
+	return validator, err
+}
+
+// deprecatedField reports the use of a deprecated field at its key. It is a
+// warning unless the field sets its own severity.
+func (r *FrontmatterRule) deprecatedField(field schema.FrontmatterField, opts reportOptions, data MessageData, key *yaml.Node) Violation {
+	if field.Severity == "" {
+		opts.severity = SeverityWarning
+	}
+	message := fmt.Sprintf("Frontmatter field '%s' is deprecated", field.Name)
+	if field.ReplacedBy != "" {
+		message = fmt.Sprintf("Frontmatter field '%s' is deprecated, use '%s' instead", field.Name, field.ReplacedBy)
+		data.Expected = field.ReplacedBy
+	}
+	line, col := nodePosition(key)
+	return opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeDeprecatedField), data)
+}
+
+// constraintError is a violated pattern, length, range, or array constraint.
+// index is the position of the offending element in an array field, or -1
+// for the whole value.
+type constraintError struct {
+	code     Code
+	message  string
+	expected any
+	index    int
+}
+
+// validateFieldConstraints checks pattern, length, range, and array
+// constraints. Each applies only to values of its kind, so a value of the
+// wrong type is left to the type check.
+func (r *FrontmatterRule) validateFieldConstraints(field schema.FrontmatterField, value any) []constraintError {
+	var errs []constraintError
+	name := field.Name
+
+	if str, ok := value.(string); ok {
+		if field.Pattern != "" && indexTextPattern(str, "", field.Pattern) < 0 {
+			errs = append(errs, constraintError{CodeFieldPatternMismatch,
+				fmt.Sprintf("Frontmatter field '%s' does not match pattern '%s'", name, field.Pattern), field.Pattern, -1})
+		}
+		length := utf8.RuneCountInString(str)
+		if field.MinLength > 0 && length < field.MinLength {
+			errs = append(errs, constraintError{CodeFieldTooShort,
+				fmt.Sprintf("Frontmatter field '%s' is too short (min %d characters, found %d)", name, field.MinLength, length), field.MinLength, -1})
+		}
+		if field.MaxLength > 0 && length > field.MaxLength {
+			errs = append(errs, constraintError{CodeFieldTooLong,
+				fmt.Sprintf("Frontmatter field '%s' is too long (max %d characters, found %d)", name, field.MaxLength, length), field.MaxLength, -1})
+		}
+	}
+
+	if n, ok := toFloat(value); ok {
+		if field.Minimum != nil && n < *field.Minimum {
+			errs = append(errs, constraintError{CodeFieldBelowMinimum,
+				fmt.Sprintf("Frontmatter field '%s' is %v, minimum is %v", name, value, *field.Minimum), *field.Minimum, -1})
+		}
+		if field.Maximum != nil && n > *field.Maximum {
+			errs = append(errs, constraintError{CodeFieldAboveMaximum,
+				fmt.Sprintf("Frontmatter field '%s' is %v, maximum is %v", name, value, *field.Maximum), *field.Maximum, -1})
+		}
+	}
+
+	if arr, ok := value.([]any); ok {
+		if field.MinItems > 0 && len(arr) < field.MinItems {
+			errs = append(errs, constraintError{CodeTooFewFieldItems,
+				fmt.Sprintf("Frontmatter field '%s' requires at least %d items, found %d", name, field.MinItems, len(arr)), field.MinItems, -1})
+		}
+		if field.MaxItems > 0 && len(arr) > field.MaxItems {
+			errs = append(errs, constraintError{CodeTooManyFieldItems,
+				fmt.Sprintf("Frontmatter field '%s' has too many items (max %d, found %d)", name, field.MaxItems, len(arr)), field.MaxItems, -1})
+		}
+		if field.UniqueItems {
+			for i := range arr {
+				if slices.ContainsFunc(arr[:i], func(prev any) bool { return enumEqual(prev, arr[i]) }) {
+					errs = append(errs, constraintError{CodeDuplicateFieldItems,
+						fmt.Sprintf("Frontmatter field '%s' contains %s more than once", name, formatEnumValue(arr[i])), nil, i})
+				}
+			}
+		}
+		if field.Items != nil {
+			for i, elem := range arr {
+				if msg := r.validateFieldItem(fmt.Sprintf("%s[%d]", name, i), elem, field.Items); msg != "" {
+					errs = append(errs, constraintError{CodeInvalidFieldItem, msg, nil, i})
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateFieldItem checks an array element against the items constraints
+func (r *FrontmatterRule) validateFieldItem(name string, elem any, items *schema.FrontmatterItems) string {
+	if items.Type != "" {
+		if err := r.validateFieldType(name, elem, items.Type); err != "" {
+			return err
+		}
+	}
+	if len(items.Enum) > 0 && !enumContains(items.Enum, elem) {
+		return fmt.Sprintf("Frontmatter field '%s' has value %s, allowed values: %s",
+			name, formatEnumValue(elem), formatEnumList(items.Enum))
+	}
+	if items.Pattern != "" {
+		str, ok := elem.(string)
+		if !ok || indexTextPattern(str, "", items.Pattern) < 0 {
+			return fmt.Sprintf("Frontmatter field '%s' value %s does not match pattern '%s'",
+				name, formatEnumValue(elem), items.Pattern)
+		}
+	}
+	return ""
+}
+
+// nodePosition returns the position of a frontmatter YAML node, or the start
+// of the document when the node is unknown
+func nodePosition(node *yaml.Node) (int, int) {
+	if node == nil {
+		return 1, 1
+	}
+	return node.Line, node.Column
+}
+
+// fieldReportOptions resolves the reporting settings of a frontmatter field.
+// The severity and help URL fall back to the frontmatter config's.
+func fieldReportOptions(field schema.FrontmatterField, config *schema.FrontmatterConfig) reportOptions {
+	helpURL := field.HelpURL
+	if helpURL == "" {
+		helpURL = config.HelpURL
+	}
+	return reportOptions{
+		severity: ruleSeverity(field.Severity, config.Severity),
+		message:  field.Message,
+		helpURL:  helpURL,
+	}
+}
+
 // splitFieldPath splits a dot-notation path into segments. A literal dot can
 // be escaped with a backslash (e.g. "weird\\.key" → ["weird.key"]).
 func splitFieldPath(name string) []string {
@@ -246,28 +638,35 @@ func (r *FrontmatterRule) validateFieldFormat(name string, value any, format sch
 	return ""
 }
 
+// enumError is a disallowed frontmatter value. index is the position of the
+// offending element in an array field, or -1 for the whole value.
+type enumError struct {
+	message string
+	index   int
+}
+
 // validateFieldEnum checks if a field value is one of the allowed values. For
 // array fields every element is checked, so a document listing several
 // disallowed values reports all of them in a single run.
-func (r *FrontmatterRule) validateFieldEnum(field schema.FrontmatterField, value any) []string {
+func (r *FrontmatterRule) validateFieldEnum(field schema.FrontmatterField, value any) []enumError {
 	if field.Type == schema.FieldTypeArray {
 		arr, ok := value.([]any)
 		if !ok {
 			// validateFieldType already reports the wrong shape.
 			return nil
 		}
-		var errs []string
-		for _, elem := range arr {
+		var errs []enumError
+		for i, elem := range arr {
 			if !enumContains(field.Enum, elem) {
-				errs = append(errs, fmt.Sprintf("Frontmatter field '%s' contains %s, allowed values: %s",
-					field.Name, formatEnumValue(elem), formatEnumList(field.Enum)))
+				errs = append(errs, enumError{fmt.Sprintf("Frontmatter field '%s' contains %s, allowed values: %s",
+					field.Name, formatEnumValue(elem), formatEnumList(field.Enum)), i})
 			}
 		}
 		return errs
 	}
 	if !enumContains(field.Enum, value) {
-		return []string{fmt.Sprintf("Frontmatter field '%s' has value %s, allowed values: %s",
-			field.Name, formatEnumValue(value), formatEnumList(field.Enum))}
+		return []enumError{{fmt.Sprintf("Frontmatter field '%s' has value %s, allowed values: %s",
+			field.Name, formatEnumValue(value), formatEnumList(field.Enum)), -1}}
 	}
 	return nil
 }
@@ -397,6 +796,63 @@ func formatEnumList(allowed []any) string {
 	return "[" + strings.Join(parts, ", ") + "]"
 }
 
+// numberPlaceholder returns the minimum, the maximum, or 0 when it is in range
+func numberPlaceholder(field schema.FrontmatterField) string {
+	switch {
+	case field.Minimum != nil && *field.Minimum > 0:
+		return fmt.Sprint(*field.Minimum)
+	case field.Maximum != nil && *field.Maximum < 0:
+		return fmt.Sprint(*field.Maximum)
+	}
+	return "0"
+}
+
+// stringPlaceholder returns a TODO placeholder padded or cut to fit the
+// length bounds
+func stringPlaceholder(minLength, maxLength int) string {
+	placeholder := "TODO"
+	for len(placeholder) < minLength {
+		placeholder += " TODO"
+	}
+	if maxLength > 0 && len(placeholder) > maxLength {
+		placeholder = placeholder[:maxLength]
+	}
+	return strings.TrimSpace(placeholder)
+}
+
+// arrayPlaceholder returns an inline array with as many elements as
+// min_items asks for (two by default, capped by max_items), each built
+// from the items constraints
+func arrayPlaceholder(field schema.FrontmatterField) string {
+	count := 2
+	if field.MinItems > count {
+		count = field.MinItems
+	}
+	if field.MaxItems > 0 && count > field.MaxItems {
+		count = field.MaxItems
+	}
+
+	elems := make([]string, count)
+	for i := range elems {
+		elems[i] = fmt.Sprintf("%q", fmt.Sprintf("item%d", i+1))
+		if field.Items == nil {
+			continue
+		}
+		switch {
+		case len(field.Items.Enum) > 0:
+			// Cycle through the enum so unique_items holds where possible
+			elems[i] = formatEnumValue(field.Items.Enum[i%len(field.Items.Enum)])
+		case field.Items.Type == schema.FieldTypeNumber:
+			elems[i] = fmt.Sprint(i + 1)
+		case field.Items.Type == schema.FieldTypeBoolean:
+			elems[i] = "false"
+		case field.Items.Type == schema.FieldTypeDate:
+			elems[i] = "2024-01-01"
+		}
+	}
+	return "[" + strings.Join(elems, ", ") + "]"
+}
+
 // isValidDateFormat checks if a string is in YYYY-MM-DD format
 func isValidDateFormat(s string) bool {
 	dateRegex := regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
@@ -478,12 +934,16 @@ func (r *FrontmatterRule) writeFrontmatterTree(builder *strings.Builder, nodes [
 			r.writeFrontmatterTree(builder, n.children, depth+1)
 			continue
 		}
-		if n.field == nil {
+		if n.field == nil || n.field.Deprecated {
 			continue
 		}
 		placeholder := r.getPlaceholder(*n.field)
+		hints := placeholderHints(*n.field)
 		if !n.field.Optional {
-			builder.WriteString(indent + n.key + ": " + placeholder + " # required\n")
+			hints = append([]string{"required"}, hints...)
+		}
+		if len(hints) > 0 {
+			builder.WriteString(indent + n.key + ": " + placeholder + " # " + strings.Join(hints, ", ") + "\n")
 		} else {
 			builder.WriteString(indent + n.key + ": " + placeholder + "\n")
 		}
@@ -491,7 +951,7 @@ func (r *FrontmatterRule) writeFrontmatterTree(builder *strings.Builder, nodes [
 }
 
 func hasRequiredDescendant(n *fmTreeNode) bool {
-	if n.field != nil && !n.field.Optional {
+	if n.field != nil && !n.field.Optional && !n.field.Deprecated {
 		return true
 	}
 	for _, c := range n.children {
@@ -502,6 +962,30 @@ func hasRequiredDescendant(n *fmTreeNode) bool {
 	return false
 }
 
+// placeholderHints describes constraints a placeholder cannot satisfy on
+// its own, such as patterns, as comments for the author
+func placeholderHints(field schema.FrontmatterField) []string {
+	var hints []string
+	if field.Pattern != "" {
+		hints = append(hints, "pattern: "+field.Pattern)
+	}
+	if field.Items != nil && field.Items.Pattern != "" {
+		hints = append(hints, "items pattern: "+field.Items.Pattern)
+	}
+	switch {
+	case field.MinLength > 0 && field.MaxLength > 0:
+		hints = append(hints, fmt.Sprintf("%d-%d characters", field.MinLength, field.MaxLength))
+	case field.MinLength > 0:
+		hints = append(hints, fmt.Sprintf("at least %d characters", field.MinLength))
+	case field.MaxLength > 0:
+		hints = append(hints, fmt.Sprintf("at most %d characters", field.MaxLength))
+	}
+	if field.UniqueItems {
+		hints = append(hints, "unique items")
+	}
+	return hints
+}
+
 // getPlaceholder returns an appropriate placeholder value based on field type/format
 func (r *FrontmatterRule) getPlaceholder(field schema.FrontmatterField) string {
 	// An enum pins the value down to a known set, so use its first entry
@@ -512,6 +996,16 @@ func (r *FrontmatterRule) getPlaceholder(field schema.FrontmatterField) string {
 		return formatEnumValue(field.Enum[0])
 	}
 
+	if field.Type == schema.FieldTypeArray && (field.Items != nil || field.MinItems > 0 || field.MaxItems > 0) {
+		return arrayPlaceholder(field)
+	}
+	if field.Type == schema.FieldTypeNumber && (field.Minimum != nil || field.Maximum != nil) {
+		return numberPlaceholder(field)
+	}
+	if (field.Type == "" || field.Type == schema.FieldTypeString) && field.Format == "" && (field.MinLength > 0 || field.MaxLength > 0) {
+		return fmt.Sprintf("%q", stringPlaceholder(field.MinLength, field.MaxLength))
+	}
+
 	// Check format first as it's more specific
 	switch field.Format {
 	case schema.FieldFormatDate:
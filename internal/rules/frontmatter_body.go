@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
+	"github.com/jackchuka/mdschema/internal/vast"
+)
+
+// FrontmatterBodyRule checks that frontmatter fields repeating document
+// content, such as a title duplicating the H1, stay in sync with it
+type FrontmatterBodyRule struct {
+}
+
+var _ Rule = (*FrontmatterBodyRule)(nil)
+
+// NewFrontmatterBodyRule creates a new frontmatter-body rule
+func NewFrontmatterBodyRule() *FrontmatterBodyRule {
+	return &FrontmatterBodyRule{}
+}
+
+// Name returns the rule identifier
+func (r *FrontmatterBodyRule) Name() string {
+	return "frontmatter-body"
+}
+
+// ValidateWithContext validates using VAST (validation-ready AST)
+func (r *FrontmatterBodyRule) ValidateWithContext(ctx *vast.Context) []Violation {
+	violations := make([]Violation, 0)
+
+	config := ctx.Schema.Frontmatter
+	fm := ctx.Tree.Document.FrontMatter
+	if config == nil || fm == nil || fm.Data == nil {
+		return violations
+	}
+
+	for _, field := range config.Fields {
+		if field.Matches == "" {
+			continue
+		}
+		value, exists := lookupField(fm.Data, field.Name)
+		str, ok := value.(string)
+		if !exists || !ok {
+			// Missing and non-string values are reported by the frontmatter rule
+			continue
+		}
+
+		_, valueNode, _ := fm.Lookup(splitFieldPath(field.Name))
+		line, col := nodePosition(valueNode)
+		opts := fieldReportOptions(field, config)
+		source := contentSourceName(field.Matches)
+
+		content, found := documentContent(ctx.Tree.Document, field.Matches)
+		if !found {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter field '%s' should match the %s, but the document has none", field.Name, source), line, col).WithCode(CodeContentMismatch),
+					MessageData{Field: field.Name, Found: str}))
+			continue
+		}
+		if normalizeSpace(str) != normalizeSpace(content) {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter field '%s' does not match the %s: found %q, expected %q", field.Name, source, str, content), line, col).WithCode(CodeContentMismatch),
+					MessageData{Field: field.Name, Found: str, Expected: content}))
+		}
+	}
+
+	return violations
+}
+
+// documentContent returns the text of a content source, and false when the
+// document has no such content
+func documentContent(doc *parser.Document, source schema.ContentSource) (string, bool) {
+	switch source {
+	case schema.ContentSourceH1:
+		for _, section := range doc.GetSections() {
+			if section.Heading.Level == 1 {
+				return section.Heading.Text, true
+			}
+		}
+	case schema.ContentSourceFirstParagraph:
+		var first *parser.Paragraph
+		for _, section := range append([]*parser.Section{doc.Root}, doc.GetSections()...) {
+			for _, p := range section.Paragraphs {
+				if first == nil || p.Line < first.Line {
+					first = p
+				}
+			}
+		}
+		if first != nil {
+			return first.Text, true
+		}
+	}
+	return "", false
+}
+
+// contentSourceName describes a content source in messages
+func contentSourceName(source schema.ContentSource) string {
+	if source == schema.ContentSourceFirstParagraph {
+		return "first paragraph"
+	}
+	return strings.ToUpper(string(source))
+}
+
+// normalizeSpace trims a string and collapses runs of whitespace
+func normalizeSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,67 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
+	"github.com/jackchuka/mdschema/internal/vast"
+)
+
+func TestFrontmatterBodyRule(t *testing.T) {
+	fields := []schema.FrontmatterField{
+		{Name: "title", Matches: schema.ContentSourceH1},
+		{Name: "description", Optional: true, Matches: schema.ContentSourceFirstParagraph},
+	}
+
+	tests := []struct {
+		name          string
+		content       string
+		wantPositions []string // "line:col" of each violation
+	}{
+		{
+			name:    "in sync",
+			content: "---\ntitle: Install Guide\ndescription: How to install   the tool.\n---\n\n# Install *Guide*\n\nHow to install\nthe tool.\n",
+		},
+		{
+			name:          "title differs from H1",
+			content:       "---\ntitle: Install Guide\n---\n\n# Setup\n",
+			wantPositions: []string{"2:8"},
+		},
+		{
+			name:          "description differs from first paragraph",
+			content:       "---\ntitle: Guide\ndescription: Old summary.\n---\n\n# Guide\n\nNew summary.\n",
+			wantPositions: []string{"3:14"},
+		},
+		{
+			name:          "no H1",
+			content:       "---\ntitle: Guide\n---\n\n## Guide\n",
+			wantPositions: []string{"2:8"},
+		},
+		{
+			name:    "missing field is left to the frontmatter rule",
+			content: "---\nauthor: me\n---\n\n# Guide\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.New().Parse("test.md", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{Fields: fields}}
+			violations := NewFrontmatterBodyRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+			if len(violations) != len(tt.wantPositions) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantPositions), len(violations), violations)
+			}
+			for i, v := range violations {
+				if pos := fmt.Sprintf("%d:%d", v.Line, v.Column); v.Code != CodeContentMismatch || pos != tt.wantPositions[i] {
+					t.Errorf("violation %d = %s at %s, want %s at %s", i, v.Code, pos, CodeContentMismatch, tt.wantPositions[i])
+				}
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package rules
 
 import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"testing"
 
@@ -795,6 +799,432 @@ metadata:
 	}
 }
 
+func TestFrontmatterRulePositions(t *testing.T) {
+	content := `---
+title: 42
+status: wip
+tags:
+  - go
+  - rust
+metadata:
+  version: "1.0"
+---
+
+# Title
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		field    schema.FrontmatterField
+		wantCode Code
+		wantLine int
+		wantCol  int
+	}{
+		{"wrong type at value", schema.FrontmatterField{Name: "title", Type: schema.FieldTypeString}, CodeWrongFieldType, 2, 8},
+		{"enum at value", schema.FrontmatterField{Name: "status", Enum: []any{"draft", "published"}}, CodeValueNotAllowed, 3, 9},
+		{"array enum at element", schema.FrontmatterField{Name: "tags", Type: schema.FieldTypeArray, Enum: []any{"go"}}, CodeValueNotAllowed, 6, 5},
+		{"nested missing at parent key", schema.FrontmatterField{Name: "metadata.author"}, CodeMissingField, 7, 1},
+		{"nested wrong type", schema.FrontmatterField{Name: "metadata.version", Type: schema.FieldTypeNumber}, CodeWrongFieldType, 8, 12},
+		{"top-level missing at start", schema.FrontmatterField{Name: "author"}, CodeMissingField, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{Fields: []schema.FrontmatterField{tt.field}}}
+			violations := NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d: %v", len(violations), violations)
+			}
+			v := violations[0]
+			if v.Code != tt.wantCode || v.Line != tt.wantLine || v.Column != tt.wantCol {
+				t.Errorf("violation = %s at %d:%d, want %s at %d:%d", v.Code, v.Line, v.Column, tt.wantCode, tt.wantLine, tt.wantCol)
+			}
+		})
+	}
+}
+
+func TestFrontmatterRuleConstraints(t *testing.T) {
+	// Values on lines 2-6, tags elements on lines 7-9
+	content := `---
+version: "1.2"
+title: Hi
+weight: -1
+priority: 9
+tags:
+  - go
+  - Go
+  - go
+author: me
+---
+
+# Title
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	zero, five := 0.0, 5.0
+	tests := []struct {
+		name      string
+		field     schema.FrontmatterField
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"pattern", schema.FrontmatterField{Name: "version", Pattern: `^v\d+`}, []Code{CodeFieldPatternMismatch}, []int{2}},
+		{"pattern skips non-strings", schema.FrontmatterField{Name: "weight", Pattern: `^v\d+`}, nil, nil},
+		{"min length", schema.FrontmatterField{Name: "title", MinLength: 5}, []Code{CodeFieldTooShort}, []int{3}},
+		{"max length", schema.FrontmatterField{Name: "title", MaxLength: 1}, []Code{CodeFieldTooLong}, []int{3}},
+		{"minimum zero", schema.FrontmatterField{Name: "weight", Minimum: &zero}, []Code{CodeFieldBelowMinimum}, []int{4}},
+		{"maximum", schema.FrontmatterField{Name: "priority", Maximum: &five}, []Code{CodeFieldAboveMaximum}, []int{5}},
+		{"min items", schema.FrontmatterField{Name: "tags", MinItems: 4}, []Code{CodeTooFewFieldItems}, []int{7}},
+		{"max items", schema.FrontmatterField{Name: "tags", MaxItems: 1}, []Code{CodeTooManyFieldItems}, []int{7}},
+		{"unique items", schema.FrontmatterField{Name: "tags", UniqueItems: true}, []Code{CodeDuplicateFieldItems}, []int{9}},
+		{"items pattern", schema.FrontmatterField{Name: "tags", Items: &schema.FrontmatterItems{Pattern: "^[a-z]+$"}}, []Code{CodeInvalidFieldItem}, []int{8}},
+		{"items enum", schema.FrontmatterField{Name: "tags", Items: &schema.FrontmatterItems{Enum: []any{"go"}}}, []Code{CodeInvalidFieldItem}, []int{8}},
+		{"items type", schema.FrontmatterField{Name: "tags", Items: &schema.FrontmatterItems{Type: schema.FieldTypeNumber}}, []Code{CodeInvalidFieldItem, CodeInvalidFieldItem, CodeInvalidFieldItem}, []int{7, 8, 9}},
+		{"deprecated", schema.FrontmatterField{Name: "author", Deprecated: true, ReplacedBy: "authors"}, []Code{CodeDeprecatedField}, []int{10}},
+		{"deprecated absent is fine", schema.FrontmatterField{Name: "category", Deprecated: true}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{Fields: []schema.FrontmatterField{tt.field}}}
+			violations := NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFrontmatterRuleDeprecatedSeverity(t *testing.T) {
+	doc, err := parser.New().Parse("test.md", []byte("---\nauthor: me\n---\n\n# T\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{Fields: []schema.FrontmatterField{
+		{Name: "author", Deprecated: true, ReplacedBy: "authors"},
+	}}}
+
+	violations := NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if violations[0].Severity != SeverityWarning || !strings.Contains(violations[0].Message, "'authors'") {
+		t.Errorf("violation = %s %q, want a warning naming the replacement", violations[0].Severity, violations[0].Message)
+	}
+}
+
+func TestFrontmatterRuleGenerateConstraints(t *testing.T) {
+	minWeight := 10.0
+	s := &schema.Schema{
+		Frontmatter: &schema.FrontmatterConfig{
+			Fields: []schema.FrontmatterField{
+				{Name: "version", Type: schema.FieldTypeString, Pattern: `^v\d+`},
+				{Name: "summary", MinLength: 10},
+				{Name: "weight", Type: schema.FieldTypeNumber, Minimum: &minWeight},
+				{Name: "tags", Type: schema.FieldTypeArray, MinItems: 3, UniqueItems: true, Items: &schema.FrontmatterItems{Enum: []any{"go", "cli"}}},
+				{Name: "author", Deprecated: true},
+			},
+		},
+	}
+
+	var builder strings.Builder
+	NewFrontmatterRule().Generate(&builder, s)
+
+	output := builder.String()
+	wantSubstrings := []string{
+		`version: "TODO" # required, pattern: ^v\d+`,
+		`summary: "TODO TODO TODO" # required, at least 10 characters`,
+		"weight: 10 # required",
+		`tags: ["go", "cli", "go"] # required, unique items`,
+	}
+	for _, want := range wantSubstrings {
+		if !strings.Contains(output, want) {
+			t.Errorf("output missing %q\nGot:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "author") {
+		t.Errorf("deprecated field should not be generated:\n%s", output)
+	}
+}
+
+func TestFrontmatterRuleJSONSchema(t *testing.T) {
+	dir := t.TempDir()
+	jsonSchema := `{
+  "type": "object",
+  "required": ["title", "date"],
+  "properties": {
+    "title": {"type": "string"},
+    "tags": {"type": "array", "items": {"type": "string"}},
+    "params": {"type": "object", "additionalProperties": false, "properties": {"toc": {"type": "boolean"}}}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(dir, "page.schema.json"), []byte(jsonSchema), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	content := `---
+title: 42
+tags:
+  - go
+  - 7
+params:
+  toc: true
+  math: true
+---
+
+# Title
+`
+	doc, err := parser.New().Parse(filepath.Join(dir, "page.md"), []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{JSONSchema: "./page.schema.json"}}
+	rule := NewFrontmatterRule()
+	violations := rule.ValidateWithContext(vast.NewContext(doc, s, dir))
+
+	// required at the document start, then by instance path
+	want := []struct {
+		line, col int
+		substr    string
+	}{
+		{1, 1, "missing required property \"date\""},
+		{8, 3, "'params.math' is not an allowed property"},
+		{5, 5, "'tags.1' must be string"},
+		{2, 8, "'title' must be string"},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("expected %d violations, got %d: %v", len(want), len(violations), violations)
+	}
+	for i, v := range violations {
+		if v.Code != CodeJSONSchemaViolation || v.Line != want[i].line || v.Column != want[i].col || !strings.Contains(v.Message, want[i].substr) {
+			t.Errorf("violation %d = %s at %d:%d %q, want %d:%d containing %q", i, v.Code, v.Line, v.Column, v.Message, want[i].line, want[i].col, want[i].substr)
+		}
+	}
+
+	// A missing schema file is reported once per document
+	s.Frontmatter.JSONSchema = "./missing.schema.json"
+	violations = rule.ValidateWithContext(vast.NewContext(doc, s, dir))
+	if len(violations) != 1 || violations[0].Code != CodeInvalidJSONSchema {
+		t.Errorf("expected one %s violation, got %v", CodeInvalidJSONSchema, violations)
+	}
+}
+
+func TestFrontmatterRuleAdditionalFields(t *testing.T) {
+	content := `---
+tittle: Hello
+x-internal: true
+metadata:
+  author: me
+  verison: "1.0"
+  x-note: ok
+---
+
+# Title
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	closed := false
+	tests := []struct {
+		name   string
+		config *schema.FrontmatterConfig
+		want   []string // "line:col message"
+	}{
+		{
+			name: "open by default",
+			config: &schema.FrontmatterConfig{Fields: []schema.FrontmatterField{
+				{Name: "title", Optional: true}, {Name: "metadata", Optional: true},
+			}},
+		},
+		{
+			name: "closed top level with suggestion",
+			config: &schema.FrontmatterConfig{AdditionalFields: &closed, Fields: []schema.FrontmatterField{
+				{Name: "title", Optional: true}, {Name: "metadata.author"},
+			}},
+			want: []string{
+				"2:1 Unknown frontmatter field 'tittle', did you mean 'title'?",
+				"3:1 Unknown frontmatter field 'x-internal'",
+			},
+		},
+		{
+			name: "allowed patterns",
+			config: &schema.FrontmatterConfig{AdditionalFields: &closed, AllowedFields: []string{"x-.*"}, Fields: []schema.FrontmatterField{
+				{Name: "title", Optional: true}, {Name: "metadata"},
+			}},
+			want: []string{"2:1 Unknown frontmatter field 'tittle', did you mean 'title'?"},
+		},
+		{
+			name: "closed nested object",
+			config: &schema.FrontmatterConfig{Fields: []schema.FrontmatterField{
+				{Name: "metadata", Type: schema.FieldTypeObject, AdditionalFields: &closed, AllowedFields: []string{"x-.*"}},
+				{Name: "metadata.author"},
+				{Name: "metadata.version", Optional: true},
+			}},
+			want: []string{"6:3 Unknown frontmatter field 'metadata.verison', did you mean 'version'?"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{Frontmatter: tt.config}
+			var got []string
+			for _, v := range NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, "")) {
+				if v.Code != CodeUnknownField {
+					t.Errorf("unexpected violation %s: %s", v.Code, v.Message)
+					continue
+				}
+				got = append(got, fmt.Sprintf("%d:%d %s", v.Line, v.Column, v.Message))
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("violations = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFrontmatterRuleAssertions(t *testing.T) {
+	content := `---
+date: 2024-03-01
+updated: 2024-02-01
+draft: false
+reviewers: []
+deprecated_in: "2.0"
+meta:
+  owner: docs
+---
+
+# Title
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		assertion    schema.FrontmatterAssertion
+		wantCode     Code
+		wantMessage  string
+		wantSeverity Severity
+	}{
+		{
+			name:      "holds",
+			assertion: schema.FrontmatterAssertion{Expr: `meta.owner == "docs" && "date" in fm`},
+		},
+		{
+			name:         "date order",
+			assertion:    schema.FrontmatterAssertion{Expr: "updated >= date", Message: "updated must be on or after date", Severity: "warning"},
+			wantCode:     CodeAssertionFailed,
+			wantMessage:  "updated must be on or after date",
+			wantSeverity: SeverityWarning,
+		},
+		{
+			name:         "non-empty when published",
+			assertion:    schema.FrontmatterAssertion{Expr: "draft || len(reviewers ?? []) > 0"},
+			wantCode:     CodeAssertionFailed,
+			wantMessage:  "Frontmatter assertion failed: draft || len(reviewers ?? []) > 0",
+			wantSeverity: SeverityError,
+		},
+		{
+			name:         "missing field is nil",
+			assertion:    schema.FrontmatterAssertion{Expr: "deprecated_in == nil || replaced_by != nil", Message: "{{.Expected}} does not hold"},
+			wantCode:     CodeAssertionFailed,
+			wantMessage:  "deprecated_in == nil || replaced_by != nil does not hold",
+			wantSeverity: SeverityError,
+		},
+		{
+			name:         "invalid expression",
+			assertion:    schema.FrontmatterAssertion{Expr: "updated >=", Message: "ignored"},
+			wantCode:     CodeInvalidAssertion,
+			wantSeverity: SeverityError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{Assertions: []schema.FrontmatterAssertion{tt.assertion}}}
+			violations := NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if tt.wantCode == "" {
+				if len(violations) != 0 {
+					t.Errorf("expected no violations, got %v", violations)
+				}
+				return
+			}
+			if len(violations) != 1 {
+				t.Fatalf("expected 1 violation, got %d: %v", len(violations), violations)
+			}
+			v := violations[0]
+			if v.Code != tt.wantCode || v.Severity != tt.wantSeverity {
+				t.Errorf("violation = %s (%s), want %s (%s)", v.Code, v.Severity, tt.wantCode, tt.wantSeverity)
+			}
+			if tt.wantMessage != "" && v.Message != tt.wantMessage {
+				t.Errorf("message = %q, want %q", v.Message, tt.wantMessage)
+			}
+			if tt.wantCode == CodeInvalidAssertion && !strings.Contains(v.Message, "could not be evaluated") {
+				t.Errorf("message = %q, want an evaluation error", v.Message)
+			}
+		})
+	}
+}
+
+func TestFrontmatterRuleFormats(t *testing.T) {
+	content := `+++
+title = 42
+date = 2024-01-15
+tags = ["go", "rust"]
+
+[params]
+draft = "yes"
++++
+
+# Title
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{Frontmatter: &schema.FrontmatterConfig{
+		Format: "yaml",
+		Fields: []schema.FrontmatterField{
+			{Name: "title", Type: schema.FieldTypeString},
+			{Name: "date", Type: schema.FieldTypeDate},
+			{Name: "tags", Type: schema.FieldTypeArray, Enum: []any{"go"}},
+			{Name: "params.draft", Type: schema.FieldTypeBoolean},
+		},
+	}}
+	violations := NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	want := []string{
+		"MDS522 1:1 Frontmatter is TOML, expected YAML",
+		"MDS504 2:9 Frontmatter field 'title' should be a string",
+		"MDS506 4:8 Frontmatter field 'tags' contains \"rust\", allowed values: [\"go\"]", // TOML array elements share the array's position
+		"MDS504 7:9 Frontmatter field 'params.draft' should be a boolean",
+	}
+	var got []string
+	for _, v := range violations {
+		got = append(got, fmt.Sprintf("%s %d:%d %s", v.Code, v.Line, v.Column, v.Message))
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("violations =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
 func TestSplitFieldPath(t *testing.T) {
 	tests := []struct {
 		input string
@@ -855,3 +1285,69 @@ func TestFrontmatterRuleGenerateNested(t *testing.T) {
 		}
 	}
 }
+
+func TestFrontmatterRuleSeverity(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("---\ntitle: Test\n---\n\n# Title\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Frontmatter: &schema.FrontmatterConfig{
+			Severity: "warning",
+			Fields: []schema.FrontmatterField{
+				{Name: "date"},
+				{Name: "author", Severity: "info"},
+			},
+		},
+	}
+
+	ctx := vast.NewContext(doc, s, "")
+	violations := NewFrontmatterRule().ValidateWithContext(ctx)
+
+	want := map[string]Severity{"date": SeverityWarning, "author": SeverityInfo}
+	if len(violations) != len(want) {
+		t.Fatalf("expected %d violations, got %d", len(want), len(violations))
+	}
+	for _, v := range violations {
+		for field, severity := range want {
+			if strings.Contains(v.Message, "'"+field+"'") && v.Severity != severity {
+				t.Errorf("field %q: Severity = %q, want %q", field, v.Severity, severity)
+			}
+		}
+	}
+}
+
+func TestFrontmatterRuleCustomMessage(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("---\nstatus: wip\n---\n\n# Title\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Frontmatter: &schema.FrontmatterConfig{
+			HelpURL: "https://example.com/frontmatter",
+			Fields: []schema.FrontmatterField{
+				{
+					Name:    "status",
+					Enum:    []any{"draft", "published"},
+					Message: "{{.Field}} is {{.Found}}; use one of {{.Expected}}",
+				},
+			},
+		},
+	}
+
+	violations := NewFrontmatterRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if want := "status is wip; use one of [draft published]"; violations[0].Message != want {
+		t.Errorf("Message = %q, want %q", violations[0].Message, want)
+	}
+	if violations[0].HelpURL != "https://example.com/frontmatter" {
+		t.Errorf("HelpURL = %q", violations[0].HelpURL)
+	}
+}
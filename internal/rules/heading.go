@@ -3,16 +3,31 @@ package rules
 import (
 	"fmt"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
 
-// HeadingRule validates heading structure across the document
+// trailingPunctuation lists characters a heading may not end with when
+// no_trailing_punctuation is set. "?" is allowed so FAQ-style headings pass.
+const trailingPunctuation = ".,;:!"
+
+// minorTitleWords may stay lowercase in title case unless they start or end the heading
+var minorTitleWords = map[string]bool{
+	"a": true, "an": true, "and": true, "as": true, "at": true, "but": true,
+	"by": true, "for": true, "from": true, "in": true, "into": true, "nor": true,
+	"of": true, "on": true, "or": true, "per": true, "the": true, "to": true,
+	"via": true, "vs": true, "with": true,
+}
+
+// HeadingRule validates heading structure and style across the document
 type HeadingRule struct {
 }
 
-var _ Rule = (*HeadingRule)(nil)
+var _ StructuralRule = (*HeadingRule)(nil)
 
 // NewHeadingRule creates a new heading rule
 func NewHeadingRule() *HeadingRule {
@@ -40,7 +55,8 @@ func (r *HeadingRule) ValidateWithContext(ctx *vast.Context) []Violation {
 
 	// Check if heading rules are configured
 	if ctx.Schema.HeadingRules == nil {
-		return violations
+		// Style rules may still be configured per structure element
+		return r.validateStyle(ctx)
 	}
 
 	rules := ctx.Schema.HeadingRules
@@ -49,6 +65,11 @@ func (r *HeadingRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	var headings []*parser.Heading
 	r.collectHeadings(ctx.Tree.Document.Root, &headings)
 
+	// Validate single h1
+	if rules.SingleH1 {
+		violations = append(violations, r.validateSingleH1(headings)...)
+	}
+
 	// Validate no skip levels
 	if rules.NoSkipLevels {
 		violations = append(violations, r.validateNoSkipLevels(headings)...)
@@ -69,6 +90,19 @@ func (r *HeadingRule) ValidateWithContext(ctx *vast.Context) []Violation {
 		violations = append(violations, r.validateMaxDepth(headings, rules.MaxDepth)...)
 	}
 
+	// Document-level violations are reported at the offending heading
+	headingText := make(map[int]string, len(headings))
+	for _, h := range headings {
+		headingText[h.Line] = h.Text
+	}
+	opts := reportOptions{severity: severityFromSchema(rules.Severity), message: rules.Message, helpURL: rules.HelpURL}
+	for i, v := range violations {
+		violations[i] = opts.apply(v, MessageData{Heading: headingText[v.Line], Max: rules.MaxDepth})
+	}
+
+	// Style violations carry the severity of their (possibly overridden) style
+	violations = append(violations, r.validateStyle(ctx)...)
+
 	return violations
 }
 
@@ -92,7 +126,7 @@ func (r *HeadingRule) validateNoSkipLevels(headings []*parser.Heading) []Violati
 		// If heading level increases by more than 1, it's a skip
 		if h.Level > prevLevel+1 {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Heading level skipped: '%s' (h%d) after h%d", h.Text, h.Level, prevLevel), h.Line, h.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Heading level skipped: '%s' (h%d) after h%d", h.Text, h.Level, prevLevel), h.Line, h.Column).WithCode(CodeSkippedHeadingLevel))
 		}
 
 		prevLevel = h.Level
@@ -111,7 +145,7 @@ func (r *HeadingRule) validateUniqueHeadings(headings []*parser.Heading) []Viola
 		normalizedText := strings.ToLower(strings.TrimSpace(h.Text))
 		if existing, ok := seen[normalizedText]; ok {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Duplicate heading '%s' (first occurrence at line %d)", h.Text, existing.Line), h.Line, h.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Duplicate heading '%s' (first occurrence at line %d)", h.Text, existing.Line), h.Line, h.Column).WithCode(CodeDuplicateHeading))
 		} else {
 			seen[normalizedText] = h
 		}
@@ -135,7 +169,7 @@ func (r *HeadingRule) validateUniquePerLevel(headings []*parser.Heading) []Viola
 		normalizedText := strings.ToLower(strings.TrimSpace(h.Text))
 		if existing, ok := seenByLevel[h.Level][normalizedText]; ok {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Duplicate h%d heading '%s' (first occurrence at line %d)", h.Level, h.Text, existing.Line), h.Line, h.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Duplicate h%d heading '%s' (first occurrence at line %d)", h.Level, h.Text, existing.Line), h.Line, h.Column).WithCode(CodeDuplicateHeadingInLevel))
 		} else {
 			seenByLevel[h.Level][normalizedText] = h
 		}
@@ -151,9 +185,250 @@ func (r *HeadingRule) validateMaxDepth(headings []*parser.Heading, maxDepth int)
 	for _, h := range headings {
 		if h.Level > maxDepth {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Heading '%s' (h%d) exceeds maximum depth of %d", h.Text, h.Level, maxDepth), h.Line, h.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Heading '%s' (h%d) exceeds maximum depth of %d", h.Text, h.Level, maxDepth), h.Line, h.Column).WithCode(CodeHeadingTooDeep))
+		}
+	}
+
+	return violations
+}
+
+// validateSingleH1 reports every h1 after the first
+func (r *HeadingRule) validateSingleH1(headings []*parser.Heading) []Violation {
+	violations := make([]Violation, 0)
+
+	var first *parser.Heading
+	for _, h := range headings {
+		if h.Level != 1 {
+			continue
+		}
+		if first == nil {
+			first = h
+			continue
+		}
+		violations = append(violations,
+			NewViolation(r.Name(), fmt.Sprintf("Multiple h1 headings: '%s' (first h1 at line %d)", h.Text, first.Line), h.Line, h.Column).WithCode(CodeMultipleH1))
+	}
+
+	return violations
+}
+
+// validateStyle checks every heading against the global style rules merged
+// with the override of the nearest enclosing structure element
+func (r *HeadingRule) validateStyle(ctx *vast.Context) []Violation {
+	violations := make([]Violation, 0)
+
+	var global schema.HeadingStyle
+	if ctx.Schema.HeadingRules != nil {
+		global = ctx.Schema.HeadingRules.HeadingStyle
+	}
+
+	// Map bound sections to their element's override
+	overrides := make(map[*parser.Section]*schema.HeadingStyle)
+	ctx.Tree.WalkBound(func(n *vast.Node) bool {
+		if n.Element.HeadingRules != nil {
+			overrides[n.Section] = n.Element.HeadingRules
+		}
+		return true
+	})
+
+	if global.IsZero() && len(overrides) == 0 {
+		return violations
+	}
+
+	for _, section := range ctx.Tree.Document.GetSections() {
+		style := global
+		for s := section; s != nil; s = s.Parent {
+			if override, ok := overrides[s]; ok {
+				style = global.Merge(override)
+				break
+			}
+		}
+		violations = append(violations, r.validateHeadingStyle(section.Heading, style)...)
+	}
+
+	return violations
+}
+
+// validateHeadingStyle checks a single heading against a style
+func (r *HeadingRule) validateHeadingStyle(h *parser.Heading, style schema.HeadingStyle) []Violation {
+	violations := make([]Violation, 0)
+	opts := reportOptions{severity: severityFromSchema(style.Severity), message: style.Message, helpURL: style.HelpURL}
+	data := MessageData{Heading: h.Text}
+
+	if style.Case != "" && style.Case != schema.HeadingCaseAny {
+		if word, problem := headingCaseProblem(h.Prose, style.Case, style.Acronyms); word != "" {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Heading '%s' should be in %s case: '%s' %s", h.Text, style.Case, word, problem), h.Line, h.Column).WithCode(CodeHeadingCase),
+					MessageData{Heading: h.Text, Found: word, Expected: string(style.Case)}))
+		}
+	}
+
+	if enabled(style.NoTrailingPunctuation) && h.Text != "" {
+		last, _ := utf8.DecodeLastRuneInString(h.Text)
+		if strings.ContainsRune(trailingPunctuation, last) {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Heading '%s' should not end with punctuation '%c'", h.Text, last), h.Line, h.Column).WithCode(CodeHeadingTrailingPunctuation),
+					MessageData{Heading: h.Text, Found: string(last)}))
 		}
 	}
 
+	if style.MaxLength > 0 {
+		if length := utf8.RuneCountInString(h.Text); length > style.MaxLength {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Heading '%s' is too long (maximum %d characters, found %d)", h.Text, style.MaxLength, length), h.Line, h.Column).WithCode(CodeHeadingTooLong),
+					MessageData{Heading: h.Text, Found: length, Max: style.MaxLength}))
+		}
+	}
+
+	if enabled(style.NoEmphasis) && h.HasEmphasis {
+		violations = append(violations,
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Heading '%s' should not contain emphasis", h.Text), h.Line, h.Column).WithCode(CodeHeadingEmphasis), data))
+	}
+
+	if enabled(style.NoCode) && h.HasCode {
+		violations = append(violations,
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Heading '%s' should not contain inline code", h.Text), h.Line, h.Column).WithCode(CodeHeadingCode), data))
+	}
+
 	return violations
 }
+
+// headingCaseProblem returns the first word breaking the requested case and
+// what is wrong with it, or "" if the text conforms. Words listed in acronyms
+// and words containing digits (versions, years) are not checked.
+func headingCaseProblem(text string, headingCase schema.HeadingCase, acronyms []string) (word, problem string) {
+	allowed := make(map[string]bool, len(acronyms))
+	for _, a := range acronyms {
+		allowed[a] = true
+	}
+
+	words := strings.Fields(text)
+	for i, raw := range words {
+		w := strings.TrimFunc(raw, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+		if w == "" || allowed[w] || strings.IndexFunc(w, unicode.IsDigit) >= 0 {
+			continue
+		}
+		first, size := utf8.DecodeRuneInString(w)
+		rest := w[size:]
+		hasUpper := strings.IndexFunc(w, unicode.IsUpper) >= 0
+
+		switch headingCase {
+		case schema.HeadingCaseLower:
+			if hasUpper {
+				return w, "should be lowercase"
+			}
+		case schema.HeadingCaseSentence:
+			if i == 0 {
+				if !unicode.IsUpper(first) {
+					return w, "should be capitalized"
+				}
+				if strings.IndexFunc(rest, unicode.IsUpper) >= 0 {
+					return w, "should be lowercase after the first letter"
+				}
+			} else if hasUpper {
+				return w, "should be lowercase"
+			}
+		case schema.HeadingCaseTitle:
+			if i > 0 && i < len(words)-1 && minorTitleWords[strings.ToLower(w)] {
+				continue
+			}
+			if !unicode.IsUpper(first) {
+				return w, "should be capitalized"
+			}
+		}
+	}
+	return "", ""
+}
+
+// describeHeadingStyle returns generator-friendly guidance for each configured style rule
+func describeHeadingStyle(style schema.HeadingStyle) []string {
+	var lines []string
+	switch style.Case {
+	case schema.HeadingCaseTitle:
+		lines = append(lines, "Use title case (capitalize each major word)")
+	case schema.HeadingCaseSentence:
+		lines = append(lines, "Use sentence case (capitalize only the first word)")
+	case schema.HeadingCaseLower:
+		lines = append(lines, "Use lowercase")
+	}
+	if style.Case != "" && style.Case != schema.HeadingCaseAny && len(style.Acronyms) > 0 {
+		lines = append(lines, "Words exempt from case rules: "+strings.Join(style.Acronyms, ", "))
+	}
+	if enabled(style.NoTrailingPunctuation) {
+		lines = append(lines, "Do not end with punctuation (. , ; : !)")
+	}
+	if style.MaxLength > 0 {
+		lines = append(lines, fmt.Sprintf("Maximum %d characters", style.MaxLength))
+	}
+	if enabled(style.NoEmphasis) {
+		lines = append(lines, "Do not use emphasis (*italic*, **bold**)")
+	}
+	if enabled(style.NoCode) {
+		lines = append(lines, "Do not use inline code")
+	}
+	return lines
+}
+
+// GenerateContent generates guidance for heading style overrides on an element
+func (r *HeadingRule) GenerateContent(builder *strings.Builder, element schema.StructureElement) bool {
+	if element.HeadingRules == nil {
+		return false
+	}
+
+	lines := describeHeadingStyle(*element.HeadingRules)
+	if len(lines) == 0 {
+		return false
+	}
+
+	builder.WriteString("<!-- Heading requirements for this section: -->\n")
+	for _, line := range lines {
+		fmt.Fprintf(builder, "<!-- %s -->\n", line)
+	}
+	builder.WriteString("\n")
+
+	return true
+}
+
+// GenerateRules generates guidance for the global heading rules
+func (r *HeadingRule) GenerateRules(builder *strings.Builder, s *schema.Schema) bool {
+	if s.HeadingRules == nil {
+		return false
+	}
+
+	rules := s.HeadingRules
+	var lines []string
+	if rules.SingleH1 {
+		lines = append(lines, "Only one h1 heading allowed")
+	}
+	if rules.NoSkipLevels {
+		lines = append(lines, "Do not skip heading levels")
+	}
+	if rules.Unique {
+		lines = append(lines, "All headings must be unique")
+	}
+	if rules.UniquePerLevel {
+		lines = append(lines, "Headings must be unique within each level")
+	}
+	if rules.MaxDepth > 0 {
+		lines = append(lines, fmt.Sprintf("Maximum heading depth: h%d", rules.MaxDepth))
+	}
+	lines = append(lines, describeHeadingStyle(rules.HeadingStyle)...)
+	if len(lines) == 0 {
+		return false
+	}
+
+	builder.WriteString("<!-- Heading rules: -->\n")
+	for _, line := range lines {
+		fmt.Fprintf(builder, "<!-- %s -->\n", line)
+	}
+	builder.WriteString("\n")
+
+	return true
+}
+
+// enabled reports whether an optional boolean setting is turned on
+func enabled(b *bool) bool {
+	return b != nil && *b
+}
@@ -310,3 +310,324 @@ func TestHeadingRuleCombinedRules(t *testing.T) {
 		t.Errorf("Should detect multiple violations, got %d: %v", len(violations), violations)
 	}
 }
+
+func TestHeadingRuleSingleH1(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Title\n\n## Section\n\n# Second Title\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			SingleH1: true,
+		},
+	}
+
+	ctx := vast.NewContext(doc, s, "")
+	violations := NewHeadingRule().ValidateWithContext(ctx)
+
+	if len(violations) != 1 {
+		t.Fatalf("Expected 1 violation for second h1, got %d: %v", len(violations), violations)
+	}
+	if violations[0].Line != 5 || !strings.Contains(violations[0].Message, "first h1 at line 1") {
+		t.Errorf("Unexpected violation: %+v", violations[0])
+	}
+}
+
+func TestHeadingRuleCase(t *testing.T) {
+	tests := []struct {
+		name      string
+		content   string
+		style     schema.HeadingCase
+		acronyms  []string
+		wantWords []string // offending words, one per violation
+	}{
+		{
+			name:    "title case valid with minor words",
+			content: "# Getting Started with the Tool\n",
+			style:   schema.HeadingCaseTitle,
+		},
+		{
+			name:      "title case lowercase major word",
+			content:   "# Getting started\n",
+			style:     schema.HeadingCaseTitle,
+			wantWords: []string{"started"},
+		},
+		{
+			name:      "title case minor word at end is capitalized",
+			content:   "# What to Look for\n",
+			style:     schema.HeadingCaseTitle,
+			wantWords: []string{"for"},
+		},
+		{
+			name:    "sentence case valid",
+			content: "# Getting started\n\n## Release notes for v1.2\n",
+			style:   schema.HeadingCaseSentence,
+		},
+		{
+			name:      "sentence case capitalized later word",
+			content:   "# Getting Started\n",
+			style:     schema.HeadingCaseSentence,
+			wantWords: []string{"Started"},
+		},
+		{
+			name:      "sentence case lowercase first word",
+			content:   "# getting started\n",
+			style:     schema.HeadingCaseSentence,
+			wantWords: []string{"getting"},
+		},
+		{
+			name:     "sentence case acronym allowlist",
+			content:  "# Using the GitHub API\n",
+			style:    schema.HeadingCaseSentence,
+			acronyms: []string{"GitHub", "API"},
+		},
+		{
+			name:      "sentence case acronym not allowlisted",
+			content:   "# Using the API\n",
+			style:     schema.HeadingCaseSentence,
+			wantWords: []string{"API"},
+		},
+		{
+			name:    "lower case ignores inline code",
+			content: "# install `MyTool` locally\n",
+			style:   schema.HeadingCaseLower,
+		},
+		{
+			name:      "lower case violation",
+			content:   "# install locally\n\n## Usage\n",
+			style:     schema.HeadingCaseLower,
+			wantWords: []string{"Usage"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.New().Parse("test.md", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			s := &schema.Schema{
+				HeadingRules: &schema.HeadingRules{
+					HeadingStyle: schema.HeadingStyle{Case: tt.style, Acronyms: tt.acronyms},
+				},
+			}
+			violations := NewHeadingRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+			if len(violations) != len(tt.wantWords) {
+				t.Fatalf("got %d violations, want %d: %v", len(violations), len(tt.wantWords), violations)
+			}
+			for i, word := range tt.wantWords {
+				if !strings.Contains(violations[i].Message, "'"+word+"'") {
+					t.Errorf("violation %d = %q, want mention of %q", i, violations[i].Message, word)
+				}
+			}
+		})
+	}
+}
+
+func TestHeadingRuleTrailingPunctuationAndLength(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Overview:\n\n## FAQ?\n\n## A very long heading indeed\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	on := true
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			HeadingStyle: schema.HeadingStyle{NoTrailingPunctuation: &on, MaxLength: 20},
+		},
+	}
+
+	violations := NewHeadingRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	if len(violations) != 2 {
+		t.Fatalf("Expected 2 violations, got %d: %v", len(violations), violations)
+	}
+	if violations[0].Line != 1 || !strings.Contains(violations[0].Message, "punctuation ':'") {
+		t.Errorf("Expected trailing punctuation violation on line 1, got %+v", violations[0])
+	}
+	if violations[1].Line != 5 || !strings.Contains(violations[1].Message, "too long") {
+		t.Errorf("Expected length violation on line 5, got %+v", violations[1])
+	}
+}
+
+func TestHeadingRuleNoEmphasisNoCode(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# The **Bold** Title\n\n## Run `make`\n\n## Plain\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	on := true
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			HeadingStyle: schema.HeadingStyle{NoEmphasis: &on, NoCode: &on},
+		},
+	}
+
+	violations := NewHeadingRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	if len(violations) != 2 {
+		t.Fatalf("Expected 2 violations, got %d: %v", len(violations), violations)
+	}
+	if !strings.Contains(violations[0].Message, "emphasis") || violations[0].Line != 1 {
+		t.Errorf("Expected emphasis violation on line 1, got %+v", violations[0])
+	}
+	if !strings.Contains(violations[1].Message, "inline code") || violations[1].Line != 3 {
+		t.Errorf("Expected inline code violation on line 3, got %+v", violations[1])
+	}
+}
+
+func TestHeadingRuleElementOverride(t *testing.T) {
+	p := parser.New()
+	content := "# My Project\n\n## Getting Started\n\n## API reference\n\n### list_items\n"
+	doc, err := p.Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			HeadingStyle: schema.HeadingStyle{Case: schema.HeadingCaseTitle},
+		},
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Pattern: "# .*"},
+				Children: []schema.StructureElement{
+					{Heading: schema.HeadingPattern{Literal: "## Getting Started"}},
+					{
+						Heading:         schema.HeadingPattern{Literal: "## API reference"},
+						AllowAdditional: true,
+						HeadingRules: &schema.HeadingStyle{
+							Case:     schema.HeadingCaseSentence,
+							Acronyms: []string{"API"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	violations := NewHeadingRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	// "## API reference" conforms to its sentence-case override; the unmatched
+	// "### list_items" inherits the override and fails on its lowercase first word.
+	if len(violations) != 1 {
+		t.Fatalf("Expected 1 violation, got %d: %v", len(violations), violations)
+	}
+	if violations[0].Line != 7 || !strings.Contains(violations[0].Message, "sentence case") {
+		t.Errorf("Expected sentence case violation on line 7, got %+v", violations[0])
+	}
+}
+
+func TestHeadingRuleSeverity(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Title\n\n### Skipped.\n\n## Notes.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	on := true
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			NoSkipLevels: true,
+			HeadingStyle: schema.HeadingStyle{NoTrailingPunctuation: &on, Severity: "warning"},
+		},
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Literal: "# Title"},
+				Children: []schema.StructureElement{
+					{Heading: schema.HeadingPattern{Literal: "### Skipped."}, Optional: true},
+					{
+						Heading:      schema.HeadingPattern{Literal: "## Notes."},
+						HeadingRules: &schema.HeadingStyle{Severity: "info"},
+					},
+				},
+			},
+		},
+	}
+
+	violations := NewHeadingRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	// The skipped level and "### Skipped." use the global severity; the
+	// "## Notes." override lowers its punctuation violation to info.
+	want := map[int][]Severity{3: {SeverityWarning, SeverityWarning}, 5: {SeverityInfo}}
+	got := make(map[int][]Severity)
+	for _, v := range violations {
+		got[v.Line] = append(got[v.Line], v.Severity)
+	}
+	for line, severities := range want {
+		if len(got[line]) != len(severities) {
+			t.Fatalf("line %d: expected %d violations, got %v", line, len(severities), violations)
+		}
+		for i, severity := range severities {
+			if got[line][i] != severity {
+				t.Errorf("line %d: Severity = %q, want %q", line, got[line][i], severity)
+			}
+		}
+	}
+}
+
+func TestHeadingRuleGenerateContent(t *testing.T) {
+	rule := NewHeadingRule()
+
+	var builder strings.Builder
+	if rule.GenerateContent(&builder, schema.StructureElement{}) {
+		t.Error("GenerateContent should return false without heading rules")
+	}
+
+	element := schema.StructureElement{
+		HeadingRules: &schema.HeadingStyle{Case: schema.HeadingCaseSentence, MaxLength: 40},
+	}
+	if !rule.GenerateContent(&builder, element) {
+		t.Fatal("GenerateContent should return true with heading rules")
+	}
+	output := builder.String()
+	for _, want := range []string{"sentence case", "Maximum 40 characters"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Generated content missing %q:\n%s", want, output)
+		}
+	}
+}
+
+func TestHeadingRuleStyleOverrideDisables(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Title\n\n## What is `mdschema`?\n\n## Why **now**?\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	on, off := true, false
+	s := &schema.Schema{
+		HeadingRules: &schema.HeadingRules{
+			HeadingStyle: schema.HeadingStyle{
+				Case:                  schema.HeadingCaseLower,
+				MaxLength:             5,
+				NoTrailingPunctuation: &on,
+				NoEmphasis:            &on,
+				NoCode:                &on,
+			},
+		},
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Literal: "# Title"},
+				HeadingRules: &schema.HeadingStyle{
+					Case:                  schema.HeadingCaseAny,
+					MaxLength:             -1,
+					NoTrailingPunctuation: &off,
+					NoEmphasis:            &off,
+					NoCode:                &off,
+				},
+				AllowAdditional: true,
+			},
+		},
+	}
+
+	violations := NewHeadingRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+	if len(violations) != 0 {
+		t.Errorf("Expected override to disable global style checks, got %v", violations)
+	}
+}
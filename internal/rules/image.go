@@ -1,10 +1,23 @@
 package rules
 
 import (
+	"encoding/xml"
+	"errors"
 	"fmt"
+	"image"
+	_ "image/gif"  // register GIF for image.DecodeConfig
+	_ "image/jpeg" // register JPEG for image.DecodeConfig
+	_ "image/png"  // register PNG for image.DecodeConfig
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
@@ -26,11 +39,12 @@ func (r *ImageRule) Name() string {
 }
 
 // validateImageRequirement validates a specific image requirement for a node
-func (r *ImageRule) validateImageRequirement(n *vast.Node, requirement schema.ImageRule) []Violation {
+func (r *ImageRule) validateImageRequirement(ctx *vast.Context, n *vast.Node, requirement schema.ImageRule) []Violation {
 	violations := make([]Violation, 0)
 	images := n.Images()
 
 	line, col := n.Location()
+	opts := sectionReportOptions(requirement.Severity, requirement.Message, requirement.HelpURL, n.Element)
 
 	// Count images (optionally filtering by format)
 	count := 0
@@ -50,6 +64,7 @@ func (r *ImageRule) validateImageRequirement(n *vast.Node, requirement schema.Im
 		}
 		count++
 	}
+	data := MessageData{Heading: n.HeadingText(), Found: count, Expected: strings.Join(requirement.Formats, ", "), Min: requirement.Min, Max: requirement.Max}
 
 	// Check minimum requirement
 	if requirement.Min > 0 && count < requirement.Min {
@@ -60,7 +75,7 @@ func (r *ImageRule) validateImageRequirement(n *vast.Node, requirement schema.Im
 				n.HeadingText(), requirement.Min, strings.Join(requirement.Formats, ", "), count)
 		}
 
-		violations = append(violations, NewViolation(r.Name(), message, line, col))
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeTooFewImages), data))
 	}
 
 	// Check maximum requirement
@@ -68,7 +83,7 @@ func (r *ImageRule) validateImageRequirement(n *vast.Node, requirement schema.Im
 		message := fmt.Sprintf("Section '%s' has too many images (max %d, found %d)",
 			n.HeadingText(), requirement.Max, count)
 
-		violations = append(violations, NewViolation(r.Name(), message, line, col))
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeTooManyImages), data))
 	}
 
 	// Check alt text requirement
@@ -76,14 +91,208 @@ func (r *ImageRule) validateImageRequirement(n *vast.Node, requirement schema.Im
 		for _, img := range images {
 			if strings.TrimSpace(img.Alt) == "" {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Image in section '%s' is missing alt text", n.HeadingText()), img.Line, img.Column))
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Image in section '%s' is missing alt text", n.HeadingText()), img.Line, img.Column).WithCode(CodeMissingImageAlt),
+						MessageData{Heading: n.HeadingText(), Found: img.URL}))
 			}
 		}
 	}
 
+	for _, img := range images {
+		violations = append(violations, r.validateAlt(n, img, requirement, opts)...)
+	}
+
+	// Local files are only read when a check needs them
+	if requirement.ValidateFiles || requirement.MaxFileSize > 0 || requirement.MaxWidth > 0 || requirement.MaxHeight > 0 {
+		docDir := filepath.Dir(ctx.Tree.Document.Path)
+		for _, img := range images {
+			violations = append(violations, r.validateFile(n, img, requirement, opts, docDir, ctx.RootDir)...)
+		}
+	}
+
+	return violations
+}
+
+// validateAlt checks alt text length and uninformative alt texts. Missing alt
+// text is reported by require_alt.
+func (r *ImageRule) validateAlt(n *vast.Node, img *parser.Image, requirement schema.ImageRule, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	alt := strings.TrimSpace(img.Alt)
+	if alt == "" {
+		return violations
+	}
+
+	if length := utf8.RuneCountInString(alt); requirement.MinAltLength > 0 && length < requirement.MinAltLength {
+		message := fmt.Sprintf("Alt text '%s' in section '%s' is too short (min %d characters, found %d)",
+			alt, n.HeadingText(), requirement.MinAltLength, length)
+		data := MessageData{Heading: n.HeadingText(), Found: alt, Min: requirement.MinAltLength}
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeShortImageAlt), data))
+	}
+
+	normalized := strings.TrimRight(alt, ".!:")
+	for _, forbidden := range requirement.AltForbidden {
+		if strings.EqualFold(normalized, forbidden) {
+			message := fmt.Sprintf("Alt text '%s' in section '%s' does not describe the image", alt, n.HeadingText())
+			data := MessageData{Heading: n.HeadingText(), Found: alt, Expected: forbidden}
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeUninformativeImageAlt), data))
+			break
+		}
+	}
+
 	return violations
 }
 
+// validateFile checks that a local image exists and is within the size and
+// dimension limits. Remote and data URLs are skipped.
+func (r *ImageRule) validateFile(n *vast.Node, img *parser.Image, requirement schema.ImageRule, opts reportOptions, docDir, rootDir string) []Violation {
+	violations := make([]Violation, 0)
+
+	ref, ok := localImagePath(img.URL)
+	if !ok {
+		return violations
+	}
+	path := resolveLocalPath(ref, docDir, rootDir)
+
+	data := MessageData{Heading: n.HeadingText(), Found: img.URL}
+	info, err := os.Stat(path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		message := fmt.Sprintf("Image '%s' in section '%s' does not exist", img.URL, n.HeadingText())
+		return append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeMissingImageFile), data))
+	case err != nil:
+		message := fmt.Sprintf("Image '%s' in section '%s' could not be read: %v", img.URL, n.HeadingText(), err)
+		return append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeUnreadableImageFile), data))
+	case info.IsDir():
+		message := fmt.Sprintf("Image '%s' in section '%s' is a directory", img.URL, n.HeadingText())
+		return append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeUnreadableImageFile), data))
+	}
+
+	if limit := requirement.MaxFileSize; limit > 0 && info.Size() > int64(limit) {
+		message := fmt.Sprintf("Image '%s' in section '%s' is too large (max %s, found %s)",
+			img.URL, n.HeadingText(), limit, schema.FileSize(info.Size()))
+		data := MessageData{Heading: n.HeadingText(), Found: schema.FileSize(info.Size()).String(), Max: int(limit)}
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeImageFileTooLarge), data))
+	}
+
+	if requirement.MaxWidth > 0 || requirement.MaxHeight > 0 {
+		width, height, ok := imageDimensions(path)
+		if ok && ((requirement.MaxWidth > 0 && width > requirement.MaxWidth) || (requirement.MaxHeight > 0 && height > requirement.MaxHeight)) {
+			message := fmt.Sprintf("Image '%s' in section '%s' is %dx%d pixels (max %s)",
+				img.URL, n.HeadingText(), width, height, dimensionLimit(requirement.MaxWidth, requirement.MaxHeight))
+			data := MessageData{Heading: n.HeadingText(), Found: fmt.Sprintf("%dx%d", width, height), Expected: dimensionLimit(requirement.MaxWidth, requirement.MaxHeight)}
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, img.Line, img.Column).WithCode(CodeImageDimensionsTooLarge), data))
+		}
+	}
+
+	return violations
+}
+
+// localImagePath returns the file path of a local image URL without query or
+// fragment. It reports false for remote and data URLs.
+func localImagePath(imageURL string) (string, bool) {
+	if imageURL == "" || strings.HasPrefix(imageURL, "//") || strings.HasPrefix(imageURL, "data:") {
+		return "", false
+	}
+	if u, err := url.Parse(imageURL); err == nil && u.Scheme != "" {
+		return "", false
+	}
+
+	ref := imageURL
+	if idx := strings.IndexAny(ref, "?#"); idx != -1 {
+		ref = ref[:idx]
+	}
+	if unescaped, err := url.PathUnescape(ref); err == nil {
+		ref = unescaped
+	}
+	return ref, ref != ""
+}
+
+// dimensionLimit formats width and height limits, e.g. "800x600" or "800 wide"
+func dimensionLimit(width, height int) string {
+	switch {
+	case width > 0 && height > 0:
+		return fmt.Sprintf("%dx%d", width, height)
+	case width > 0:
+		return fmt.Sprintf("%d wide", width)
+	default:
+		return fmt.Sprintf("%d high", height)
+	}
+}
+
+// imageDimensions reads the pixel size of a PNG, JPEG, GIF or SVG file from
+// its header. It reports false for other formats and unreadable files.
+func imageDimensions(path string) (int, int, bool) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, false
+	}
+	defer func() { _ = f.Close() }()
+
+	if strings.EqualFold(filepath.Ext(path), ".svg") {
+		return svgDimensions(f)
+	}
+
+	cfg, _, err := image.DecodeConfig(f)
+	if err != nil {
+		return 0, 0, false
+	}
+	return cfg.Width, cfg.Height, true
+}
+
+// svgDimensions reads the width and height attributes of the root svg
+// element, falling back to the viewBox for missing or relative sizes
+func svgDimensions(r io.Reader) (int, int, bool) {
+	decoder := xml.NewDecoder(r)
+	for {
+		tok, err := decoder.Token()
+		if err != nil {
+			return 0, 0, false
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "svg" {
+			return 0, 0, false
+		}
+
+		var width, height float64
+		var viewBox []string
+		for _, attr := range start.Attr {
+			switch attr.Name.Local {
+			case "width":
+				width = svgLength(attr.Value)
+			case "height":
+				height = svgLength(attr.Value)
+			case "viewBox":
+				viewBox = strings.Fields(strings.ReplaceAll(attr.Value, ",", " "))
+			}
+		}
+		if len(viewBox) == 4 {
+			if width == 0 {
+				width, _ = strconv.ParseFloat(viewBox[2], 64)
+			}
+			if height == 0 {
+				height, _ = strconv.ParseFloat(viewBox[3], 64)
+			}
+		}
+		if width <= 0 || height <= 0 {
+			return 0, 0, false
+		}
+		return int(width), int(height), true
+	}
+}
+
+// svgLength parses an absolute SVG length in pixels ("120" or "120px").
+// Relative lengths such as "100%" or "2em" return 0.
+func svgLength(value string) float64 {
+	value = strings.TrimSuffix(strings.TrimSpace(value), "px")
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
 // ValidateWithContext validates using VAST (validation-ready AST)
 func (r *ImageRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	violations := make([]Violation, 0)
@@ -92,7 +301,7 @@ func (r *ImageRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	ctx.Tree.WalkBound(func(n *vast.Node) bool {
 		if n.Element.SectionRules != nil && len(n.Element.Images) > 0 {
 			for _, requirement := range n.Element.Images {
-				violations = append(violations, r.validateImageRequirement(n, requirement)...)
+				violations = append(violations, r.validateImageRequirement(ctx, n, requirement)...)
 			}
 		}
 		return true
@@ -122,6 +331,15 @@ func (r *ImageRule) GenerateContent(builder *strings.Builder, element schema.Str
 		if len(rule.Formats) > 0 {
 			fmt.Fprintf(builder, "<!-- Allowed formats: %s -->\n", strings.Join(rule.Formats, ", "))
 		}
+		if rule.MaxFileSize > 0 {
+			fmt.Fprintf(builder, "<!-- Maximum file size %s -->\n", rule.MaxFileSize)
+		}
+		if rule.MaxWidth > 0 || rule.MaxHeight > 0 {
+			fmt.Fprintf(builder, "<!-- Maximum dimensions %s pixels -->\n", dimensionLimit(rule.MaxWidth, rule.MaxHeight))
+		}
+		if rule.MinAltLength > 0 {
+			fmt.Fprintf(builder, "<!-- Alt text of at least %d characters -->\n", rule.MinAltLength)
+		}
 	}
 	builder.WriteString("\n")
 
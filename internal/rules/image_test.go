@@ -1,6 +1,10 @@
 package rules
 
 import (
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -179,6 +183,84 @@ func TestImageRuleSufficient(t *testing.T) {
 	}
 }
 
+func TestImageRuleFiles(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "img"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := os.Create(filepath.Join(dir, "img", "wide.png"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := png.Encode(f, image.NewRGBA(image.Rect(0, 0, 400, 100))); err != nil {
+		t.Fatal(err)
+	}
+	_ = f.Close()
+
+	svg := `<?xml version="1.0"?><svg xmlns="http://www.w3.org/2000/svg" width="100%" viewBox="0 0 64 48"></svg>`
+	if err := os.WriteFile(filepath.Join(dir, "img", "logo.svg"), []byte(svg), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Images on lines 3, 5, 7, 9, 11 and 13
+	content := `# Screenshots
+
+![Wide banner](img/wide.png)
+
+![Logo](/img/logo.svg)
+
+![Missing diagram](./img/missing.png)
+
+![Remote](https://example.com/missing.png)
+
+![Image folder](img)
+
+![Nested banner](img/wide.png/inner.png)
+`
+	doc, err := parser.New().Parse(filepath.Join(dir, "guide.md"), []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		rule      schema.ImageRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"files exist", schema.ImageRule{ValidateFiles: true}, []Code{CodeMissingImageFile, CodeUnreadableImageFile, CodeUnreadableImageFile}, []int{7, 11, 13}},
+		{"file size", schema.ImageRule{MaxFileSize: 10}, []Code{CodeImageFileTooLarge, CodeImageFileTooLarge, CodeMissingImageFile, CodeUnreadableImageFile, CodeUnreadableImageFile}, []int{3, 5, 7, 11, 13}},
+		{"max width", schema.ImageRule{MaxWidth: 200}, []Code{CodeImageDimensionsTooLarge, CodeMissingImageFile, CodeUnreadableImageFile, CodeUnreadableImageFile}, []int{3, 7, 11, 13}},
+		{"max height from svg viewBox", schema.ImageRule{MaxHeight: 40}, []Code{CodeImageDimensionsTooLarge, CodeImageDimensionsTooLarge, CodeMissingImageFile, CodeUnreadableImageFile, CodeUnreadableImageFile}, []int{3, 5, 7, 11, 13}},
+		{"min alt length", schema.ImageRule{MinAltLength: 7}, []Code{CodeShortImageAlt, CodeShortImageAlt}, []int{5, 9}},
+		{"alt forbidden", schema.ImageRule{AltForbidden: []string{"logo", "image"}}, []Code{CodeUninformativeImageAlt}, []int{5}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading:      schema.HeadingPattern{Pattern: "# Screenshots"},
+						SectionRules: &schema.SectionRules{Images: []schema.ImageRule{tt.rule}},
+					},
+				},
+			}
+
+			violations := NewImageRule().ValidateWithContext(vast.NewContext(doc, s, dir))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
 func TestImageRuleGenerateContent(t *testing.T) {
 	rule := NewImageRule()
 	var builder strings.Builder
@@ -51,8 +51,15 @@ func (r *LinkValidationRule) ValidateWithContext(ctx *vast.Context) []Violation
 	// Get document directory for relative path resolution
 	docDir := filepath.Dir(ctx.Tree.Document.Path)
 
+	opts := reportOptions{
+		severity: severityFromSchema(linkRule.Severity),
+		message:  linkRule.Message,
+		helpURL:  linkRule.HelpURL,
+	}
 	for _, link := range links {
-		violations = append(violations, r.validateLink(link, linkRule, ctx, docDir)...)
+		for _, v := range r.validateLink(link, linkRule, ctx, docDir) {
+			violations = append(violations, opts.apply(v, MessageData{Found: link.URL}))
+		}
 	}
 
 	return violations
@@ -76,12 +83,14 @@ func (r *LinkValidationRule) validateLink(link *parser.Link, rule *schema.LinkRu
 
 	url := link.URL
 
+	violations = append(violations, r.validateLinkText(link, rule)...)
+
 	// Anchor links (#section-name)
 	if anchor, found := strings.CutPrefix(url, "#"); found {
 		if rule.ValidateInternal {
 			if !ctx.HasSlug(anchor) {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Broken internal link: anchor '%s' does not exist in the document", url), link.Line, link.Column))
+					NewViolation(r.Name(), fmt.Sprintf("Broken internal link: anchor '%s' does not exist in the document", url), link.Line, link.Column).WithCode(CodeBrokenAnchor))
 			}
 		}
 		return violations
@@ -93,6 +102,15 @@ func (r *LinkValidationRule) validateLink(link *parser.Link, rule *schema.LinkRu
 		return violations
 	}
 
+	// Other schemes (mailto:, tel:, ...) are not files
+	if scheme := urlScheme(url); scheme != "" {
+		if len(rule.AllowedSchemes) > 0 && !containsFold(rule.AllowedSchemes, scheme) {
+			violations = append(violations,
+				NewViolation(r.Name(), fmt.Sprintf("Link scheme '%s' is not allowed: %s", scheme, url), link.Line, link.Column).WithCode(CodeDisallowedScheme))
+		}
+		return violations
+	}
+
 	// File links (relative paths)
 	if rule.ValidateFiles {
 		violations = append(violations, r.validateFileLink(link, docDir, ctx.RootDir)...)
@@ -108,18 +126,31 @@ func (r *LinkValidationRule) validateExternalLink(link *parser.Link, rule *schem
 	parsedURL, err := url.Parse(link.URL)
 	if err != nil {
 		violations = append(violations,
-			NewViolation(r.Name(), fmt.Sprintf("Invalid URL format: %s", link.URL), link.Line, link.Column))
+			NewViolation(r.Name(), fmt.Sprintf("Invalid URL format: %s", link.URL), link.Line, link.Column).WithCode(CodeInvalidURL))
 		return violations
 	}
 
 	host := parsedURL.Hostname()
 
+	if rule.RequireHTTPS && parsedURL.Scheme == "http" {
+		violations = append(violations,
+			NewViolation(r.Name(), fmt.Sprintf("Insecure link: use https:// instead of %s", link.URL), link.Line, link.Column).WithCode(CodeInsecureLink))
+	}
+
+	for _, domain := range rule.PreferRelativeFor {
+		if matchesDomain(host, domain) {
+			violations = append(violations,
+				NewViolation(r.Name(), fmt.Sprintf("Link to '%s' should be a relative path: %s", host, link.URL), link.Line, link.Column).WithCode(CodePreferRelativeLink))
+			break
+		}
+	}
+
 	// Check blocked domains
 	if len(rule.BlockedDomains) > 0 {
 		for _, blocked := range rule.BlockedDomains {
-			if strings.EqualFold(host, blocked) || strings.HasSuffix(strings.ToLower(host), "."+strings.ToLower(blocked)) {
+			if matchesDomain(host, blocked) {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Link to blocked domain: %s", host), link.Line, link.Column))
+					NewViolation(r.Name(), fmt.Sprintf("Link to blocked domain: %s", host), link.Line, link.Column).WithCode(CodeBlockedDomain))
 				return violations
 			}
 		}
@@ -129,14 +160,14 @@ func (r *LinkValidationRule) validateExternalLink(link *parser.Link, rule *schem
 	if len(rule.AllowedDomains) > 0 {
 		allowed := false
 		for _, domain := range rule.AllowedDomains {
-			if strings.EqualFold(host, domain) || strings.HasSuffix(strings.ToLower(host), "."+strings.ToLower(domain)) {
+			if matchesDomain(host, domain) {
 				allowed = true
 				break
 			}
 		}
 		if !allowed {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Link to domain '%s' is not in the allowed domains list", host), link.Line, link.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Link to domain '%s' is not in the allowed domains list", host), link.Line, link.Column).WithCode(CodeDomainNotAllowed))
 			return violations
 		}
 	}
@@ -154,7 +185,7 @@ func (r *LinkValidationRule) validateExternalLink(link *parser.Link, rule *schem
 		req, err := http.NewRequestWithContext(ctx, http.MethodHead, link.URL, nil)
 		if err != nil {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Failed to create request for URL: %s", link.URL), link.Line, link.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Failed to create request for URL: %s", link.URL), link.Line, link.Column).WithCode(CodeUnreachableURL))
 			return violations
 		}
 
@@ -167,7 +198,7 @@ func (r *LinkValidationRule) validateExternalLink(link *parser.Link, rule *schem
 		resp, err := client.Do(req)
 		if err != nil {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("Failed to reach URL '%s': %v", link.URL, err), link.Line, link.Column))
+				NewViolation(r.Name(), fmt.Sprintf("Failed to reach URL '%s': %v", link.URL, err), link.Line, link.Column).WithCode(CodeUnreachableURL))
 			return violations
 		}
 		defer func() {
@@ -176,13 +207,82 @@ func (r *LinkValidationRule) validateExternalLink(link *parser.Link, rule *schem
 
 		if resp.StatusCode >= 400 {
 			violations = append(violations,
-				NewViolation(r.Name(), fmt.Sprintf("URL '%s' returned status %d", link.URL, resp.StatusCode), link.Line, link.Column))
+				NewViolation(r.Name(), fmt.Sprintf("URL '%s' returned status %d", link.URL, resp.StatusCode), link.Line, link.Column).WithCode(CodeURLErrorStatus))
 		}
 	}
 
 	return violations
 }
 
+// validateLinkText checks the link text against forbidden texts and bare URLs
+func (r *LinkValidationRule) validateLinkText(link *parser.Link, rule *schema.LinkRule) []Violation {
+	violations := make([]Violation, 0)
+
+	text := strings.TrimSpace(link.Text)
+	if text == "" {
+		return violations
+	}
+
+	normalized := strings.Join(strings.Fields(strings.TrimRight(text, ".!:")), " ")
+	if containsFold(rule.ForbiddenText, normalized) {
+		violations = append(violations,
+			NewViolation(r.Name(), fmt.Sprintf("Link text '%s' does not describe the target %s", text, link.URL), link.Line, link.Column).WithCode(CodeForbiddenLinkText))
+	}
+
+	if rule.NoURLText && isURLText(text) {
+		violations = append(violations,
+			NewViolation(r.Name(), fmt.Sprintf("Link text is a bare URL: %s", text), link.Line, link.Column).WithCode(CodeURLAsLinkText))
+	}
+
+	return violations
+}
+
+// isURLText reports whether link text is a URL rather than a description
+func isURLText(text string) bool {
+	if strings.ContainsAny(text, " \t") {
+		return false
+	}
+	lower := strings.ToLower(text)
+	return strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") || strings.HasPrefix(lower, "www.")
+}
+
+// urlScheme returns the lower-cased scheme of an absolute URL, or "" for
+// relative paths and anchors
+func urlScheme(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Scheme)
+}
+
+// matchesDomain reports whether host is domain or one of its subdomains
+func matchesDomain(host, domain string) bool {
+	return strings.EqualFold(host, domain) || strings.HasSuffix(strings.ToLower(host), "."+strings.ToLower(domain))
+}
+
+// containsFold reports whether values contains s, ignoring case
+func containsFold(values []string, s string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLocalPath resolves a local file reference from a link or image.
+// Paths starting with "/" are resolved relative to rootDir (typically the
+// schema directory); other paths relative to the document's directory.
+func resolveLocalPath(ref, docDir, rootDir string) string {
+	if strings.HasPrefix(ref, "/") && rootDir != "" {
+		// Root-relative path: resolve from rootDir
+		return filepath.Clean(filepath.Join(rootDir, ref[1:])) // Strip leading "/"
+	}
+	// Document-relative path: resolve from document directory
+	return filepath.Clean(filepath.Join(docDir, ref))
+}
+
 // validateFileLink validates a relative file path link
 func (r *LinkValidationRule) validateFileLink(link *parser.Link, docDir string, rootDir string) []Violation {
 	violations := make([]Violation, 0)
@@ -198,22 +298,9 @@ func (r *LinkValidationRule) validateFileLink(link *parser.Link, docDir string,
 		return violations
 	}
 
-	// Determine base directory for path resolution
-	// Paths starting with "/" are resolved relative to rootDir (typically schema directory)
-	// Other paths are resolved relative to the document's directory
-	var targetPath string
-	if strings.HasPrefix(linkURL, "/") && rootDir != "" {
-		// Root-relative path: resolve from rootDir
-		targetPath = filepath.Join(rootDir, linkURL[1:]) // Strip leading "/"
-	} else {
-		// Document-relative path: resolve from document directory
-		targetPath = filepath.Join(docDir, linkURL)
-	}
-	targetPath = filepath.Clean(targetPath)
-
-	if _, err := os.Stat(targetPath); os.IsNotExist(err) {
+	if _, err := os.Stat(resolveLocalPath(linkURL, docDir, rootDir)); os.IsNotExist(err) {
 		violations = append(violations,
-			NewViolation(r.Name(), fmt.Sprintf("Broken file link: '%s' does not exist", link.URL), link.Line, link.Column))
+			NewViolation(r.Name(), fmt.Sprintf("Broken file link: '%s' does not exist", link.URL), link.Line, link.Column).WithCode(CodeBrokenFileLink))
 	}
 
 	return violations
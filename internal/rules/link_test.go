@@ -657,3 +657,75 @@ func TestLinkValidationRootRelativePathBroken(t *testing.T) {
 		t.Error("Expected violation mentioning broken root-relative link")
 	}
 }
+
+func TestLinkValidationSeverity(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Title\n\n[link](https://blocked.com/page)\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Links: &schema.LinkRule{
+			BlockedDomains: []string{"blocked.com"},
+			Severity:       "warning",
+		},
+	}
+
+	ctx := vast.NewContext(doc, s, "")
+	violations := NewLinkValidationRule().ValidateWithContext(ctx)
+
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if violations[0].Severity != SeverityWarning {
+		t.Errorf("Severity = %q, want %q", violations[0].Severity, SeverityWarning)
+	}
+}
+
+func TestLinkValidationTextAndStyle(t *testing.T) {
+	// Links on lines 3-9
+	content := `# Title
+
+See the [setup guide](./setup.md) or [click here](./setup.md).
+Read more [Here.](https://example.com/more).
+Visit [https://example.com/docs](https://example.com/docs).
+The [old site](http://example.com) is insecure.
+Mail [the team](mailto:team@example.com) or [call us](tel:+100).
+Run [script](javascript:alert(1)).
+Read the [docs page](https://docs.example.com/setup).
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		rule      schema.LinkRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"forbidden text", schema.LinkRule{ForbiddenText: []string{"click here", "here"}}, []Code{CodeForbiddenLinkText, CodeForbiddenLinkText}, []int{3, 4}},
+		{"url as text", schema.LinkRule{NoURLText: true}, []Code{CodeURLAsLinkText}, []int{5}},
+		{"require https", schema.LinkRule{RequireHTTPS: true}, []Code{CodeInsecureLink}, []int{6}},
+		{"allowed schemes", schema.LinkRule{AllowedSchemes: []string{"mailto", "tel"}}, []Code{CodeDisallowedScheme}, []int{8}},
+		{"prefer relative", schema.LinkRule{PreferRelativeFor: []string{"example.com"}}, []Code{CodePreferRelativeLink, CodePreferRelativeLink, CodePreferRelativeLink, CodePreferRelativeLink}, []int{4, 5, 6, 9}},
+		{"schemes are not files", schema.LinkRule{ValidateFiles: true}, []Code{CodeBrokenFileLink, CodeBrokenFileLink}, []int{3, 3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{Links: &tt.rule}
+			violations := NewLinkValidationRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
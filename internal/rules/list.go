@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
@@ -30,20 +31,27 @@ func (r *ListRule) validateListRequirement(n *vast.Node, requirement schema.List
 	lists := n.Lists()
 
 	line, col := n.Location()
+	opts := sectionReportOptions(requirement.Severity, requirement.Message, requirement.HelpURL, n.Element)
 
 	// Filter lists by type if specified
 	var matchingLists int
+	matching := make([]*parser.List, 0, len(lists))
 	for _, list := range lists {
 		if requirement.Type == "" {
 			// Any list type matches
 			matchingLists++
+			matching = append(matching, list)
 		} else if requirement.Type == schema.ListTypeOrdered && list.IsOrdered {
 			matchingLists++
+			matching = append(matching, list)
 		} else if requirement.Type == schema.ListTypeUnordered && !list.IsOrdered {
 			matchingLists++
+			matching = append(matching, list)
 		}
 	}
 
+	data := MessageData{Heading: n.HeadingText(), Found: matchingLists, Expected: string(requirement.Type), Min: requirement.Min, Max: requirement.Max}
+
 	// Check minimum requirement
 	if requirement.Min > 0 && matchingLists < requirement.Min {
 		message := fmt.Sprintf("Section '%s' requires at least %d lists, found %d",
@@ -53,7 +61,7 @@ func (r *ListRule) validateListRequirement(n *vast.Node, requirement schema.List
 				n.HeadingText(), requirement.Min, requirement.Type, matchingLists)
 		}
 
-		violations = append(violations, NewViolation(r.Name(), message, line, col))
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeTooFewLists), data))
 	}
 
 	// Check maximum requirement
@@ -65,7 +73,70 @@ func (r *ListRule) validateListRequirement(n *vast.Node, requirement schema.List
 				n.HeadingText(), requirement.Type, requirement.Max, matchingLists)
 		}
 
-		violations = append(violations, NewViolation(r.Name(), message, line, col))
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeTooManyLists), data))
+	}
+
+	for _, list := range matching {
+		violations = append(violations, r.validateListItems(n, list, requirement, opts)...)
+	}
+
+	return violations
+}
+
+// validateListItems checks item counts, item patterns, nesting depth and
+// task state of a single list
+func (r *ListRule) validateListItems(n *vast.Node, list *parser.List, requirement schema.ListRule, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	items := len(list.Items)
+
+	if requirement.MaxDepth > 0 && list.Level > requirement.MaxDepth {
+		message := fmt.Sprintf("List in section '%s' is nested %d levels deep (max %d)",
+			n.HeadingText(), list.Level, requirement.MaxDepth)
+		data := MessageData{Heading: n.HeadingText(), Found: list.Level, Max: requirement.MaxDepth}
+		violations = append(violations, opts.apply(NewViolation(r.Name(), message, list.Line, list.Column).WithCode(CodeListTooDeep), data))
+	}
+
+	// Counts and patterns apply to top-level lists; nested lists are
+	// details of an item
+	if list.Level == 1 {
+		data := MessageData{Heading: n.HeadingText(), Found: items, Min: requirement.MinItems, Max: requirement.MaxItems}
+		if requirement.MinItems > 0 && items < requirement.MinItems {
+			message := fmt.Sprintf("List in section '%s' requires at least %d items, found %d",
+				n.HeadingText(), requirement.MinItems, items)
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, list.Line, list.Column).WithCode(CodeTooFewListItems), data))
+		}
+		if requirement.MaxItems > 0 && items > requirement.MaxItems {
+			message := fmt.Sprintf("List in section '%s' has too many items (max %d, found %d)",
+				n.HeadingText(), requirement.MaxItems, items)
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, list.Line, list.Column).WithCode(CodeTooManyListItems), data))
+		}
+
+		if requirement.ItemPattern != "" {
+			for _, item := range list.Items {
+				if indexTextPattern(item.Text, "", requirement.ItemPattern) >= 0 {
+					continue
+				}
+				message := fmt.Sprintf("List item '%s' in section '%s' does not match pattern '%s'",
+					item.Text, n.HeadingText(), requirement.ItemPattern)
+				data := MessageData{Heading: n.HeadingText(), Found: item.Text, Expected: requirement.ItemPattern}
+				violations = append(violations, opts.apply(NewViolation(r.Name(), message, item.Line, item.Column).WithCode(CodeInvalidListItem), data))
+			}
+		}
+	}
+
+	for _, item := range list.Items {
+		if !item.IsTask {
+			continue
+		}
+		data := MessageData{Heading: n.HeadingText(), Found: item.Text, Expected: string(requirement.Tasks)}
+		switch {
+		case requirement.Tasks == schema.TaskStateAllChecked && !item.Checked:
+			message := fmt.Sprintf("Task '%s' in section '%s' is not checked", item.Text, n.HeadingText())
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, item.Line, item.Column).WithCode(CodeUncheckedTask), data))
+		case requirement.Tasks == schema.TaskStateNoneChecked && item.Checked:
+			message := fmt.Sprintf("Task '%s' in section '%s' is already checked", item.Text, n.HeadingText())
+			violations = append(violations, opts.apply(NewViolation(r.Name(), message, item.Line, item.Column).WithCode(CodeCheckedTask), data))
+		}
 	}
 
 	return violations
@@ -114,6 +185,18 @@ func (r *ListRule) GenerateContent(builder *strings.Builder, element schema.Stru
 		if rule.MinItems > 0 {
 			fmt.Fprintf(builder, "<!-- Minimum %d items per list -->\n", rule.MinItems)
 		}
+		if rule.MaxItems > 0 {
+			fmt.Fprintf(builder, "<!-- Maximum %d items per list -->\n", rule.MaxItems)
+		}
+		if rule.ItemPattern != "" {
+			fmt.Fprintf(builder, "<!-- Items must match: %s -->\n", rule.ItemPattern)
+		}
+		if rule.MaxDepth > 0 {
+			fmt.Fprintf(builder, "<!-- Maximum nesting depth %d -->\n", rule.MaxDepth)
+		}
+		if rule.Tasks != "" {
+			fmt.Fprintf(builder, "<!-- Tasks must be %s -->\n", strings.ReplaceAll(string(rule.Tasks), "_", " "))
+		}
 	}
 	builder.WriteString("\n")
 
@@ -127,11 +210,24 @@ func (r *ListRule) GenerateContent(builder *strings.Builder, element schema.Stru
 					itemCount = rule.MinItems
 				}
 
+				if rule.MaxItems > 0 && itemCount > rule.MaxItems {
+					itemCount = rule.MaxItems
+				}
+
+				// Task placeholders start unchecked unless all must be checked
+				task := ""
+				switch rule.Tasks {
+				case schema.TaskStateAllChecked:
+					task = "[x] "
+				case schema.TaskStateNoneChecked:
+					task = "[ ] "
+				}
+
 				for j := 0; j < itemCount; j++ {
 					if isOrdered {
-						fmt.Fprintf(builder, "%d. TODO: Add list item\n", j+1)
+						fmt.Fprintf(builder, "%d. %sTODO: Add list item\n", j+1, task)
 					} else {
-						builder.WriteString("- TODO: Add list item\n")
+						fmt.Fprintf(builder, "- %sTODO: Add list item\n", task)
 					}
 				}
 				builder.WriteString("\n")
@@ -210,6 +210,78 @@ func TestListRuleSufficient(t *testing.T) {
 	}
 }
 
+func TestListRuleItems(t *testing.T) {
+	// Top-level items on lines 3-5, nested list on line 6
+	content := `# Release
+
+- [x] [#12] Tag release
+- [ ] [#13] Publish notes
+- [x] Update docs
+  - [ ] [#14] Screenshots
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		rule      schema.ListRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"items within limits", schema.ListRule{MinItems: 3, MaxItems: 3}, nil, nil},
+		{"too few items", schema.ListRule{MinItems: 4}, []Code{CodeTooFewListItems}, []int{3}},
+		{"too many items", schema.ListRule{MaxItems: 2}, []Code{CodeTooManyListItems}, []int{3}},
+		{"item pattern", schema.ListRule{ItemPattern: `^\[#\d+\]`}, []Code{CodeInvalidListItem}, []int{5}},
+		{"max depth", schema.ListRule{MaxDepth: 1}, []Code{CodeListTooDeep}, []int{6}},
+		{"depth within limit", schema.ListRule{MaxDepth: 2}, nil, nil},
+		{"all checked", schema.ListRule{Tasks: schema.TaskStateAllChecked}, []Code{CodeUncheckedTask, CodeUncheckedTask}, []int{4, 6}},
+		{"none checked", schema.ListRule{Tasks: schema.TaskStateNoneChecked}, []Code{CodeCheckedTask, CodeCheckedTask}, []int{3, 5}},
+		{"type filter skips lists", schema.ListRule{Type: schema.ListTypeOrdered, MinItems: 10}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading:      schema.HeadingPattern{Pattern: "# Release"},
+						SectionRules: &schema.SectionRules{Lists: []schema.ListRule{tt.rule}},
+					},
+				},
+			}
+
+			violations := NewListRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestListRuleGenerateContentTasks(t *testing.T) {
+	var builder strings.Builder
+	element := schema.StructureElement{
+		Heading: schema.HeadingPattern{Pattern: "## Checklist"},
+		SectionRules: &schema.SectionRules{
+			Lists: []schema.ListRule{{Min: 1, MaxItems: 2, Tasks: schema.TaskStateNoneChecked}},
+		},
+	}
+
+	NewListRule().GenerateContent(&builder, element)
+
+	content := builder.String()
+	if got := strings.Count(content, "- [ ] TODO"); got != 2 {
+		t.Errorf("expected 2 unchecked task placeholders, got %d:\n%s", got, content)
+	}
+}
+
 func TestListRuleGenerateContent(t *testing.T) {
 	rule := NewListRule()
 	var builder strings.Builder
@@ -33,15 +33,17 @@ func (r *ParagraphRule) ValidateWithContext(ctx *vast.Context) []Violation {
 			rule := n.Element.Paragraphs
 			count := len(n.Paragraphs())
 			line, col := n.Location()
+			opts := sectionReportOptions(rule.Severity, rule.Message, rule.HelpURL, n.Element)
+			data := MessageData{Heading: n.HeadingText(), Found: count, Min: rule.Min, Max: rule.Max}
 
 			if rule.Min > 0 && count < rule.Min {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too few paragraphs (minimum %d, found %d)", n.HeadingText(), rule.Min, count), line, col))
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too few paragraphs (minimum %d, found %d)", n.HeadingText(), rule.Min, count), line, col).WithCode(CodeTooFewParagraphs), data))
 			}
 
 			if rule.Max > 0 && count > rule.Max {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too many paragraphs (maximum %d, found %d)", n.HeadingText(), rule.Max, count), line, col))
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too many paragraphs (maximum %d, found %d)", n.HeadingText(), rule.Max, count), line, col).WithCode(CodeTooManyParagraphs), data))
 			}
 		}
 		return true
@@ -5,6 +5,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
@@ -39,8 +40,11 @@ func (r *RequiredTextRule) ValidateWithContext(ctx *vast.Context) []Violation {
 						patternStr = pattern.Pattern
 					}
 					line, col := n.Location()
+					opts := sectionReportOptions(pattern.Severity, pattern.Message, pattern.HelpURL, n.Element)
+					data := MessageData{Heading: n.HeadingText(), Expected: patternStr}
+					message := fmt.Sprintf("Required text '%s' not found in section '%s' (%s)", patternStr, n.HeadingText(), sectionSpan(n.Section))
 					violations = append(violations,
-						NewViolation(r.Name(), fmt.Sprintf("Required text '%s' not found in section '%s'", patternStr, n.HeadingText()), line, col))
+						opts.apply(NewViolation(r.Name(), message, line, col).WithCode(CodeMissingRequiredText), data))
 				}
 			}
 		}
@@ -70,6 +74,22 @@ func (r *RequiredTextRule) GenerateContent(builder *strings.Builder, element sch
 	return true
 }
 
+// sectionSpan describes the lines of a section's content that were searched,
+// ignoring surrounding blank lines
+func sectionSpan(section *parser.Section) string {
+	trimmed := strings.TrimSpace(section.Content)
+	if trimmed == "" {
+		return "section is empty"
+	}
+	offset := strings.Index(section.Content, trimmed)
+	start, _ := sectionPosition(section, offset)
+	end, _ := sectionPosition(section, offset+len(trimmed))
+	if start == end {
+		return fmt.Sprintf("line %d", start)
+	}
+	return fmt.Sprintf("lines %d-%d", start, end)
+}
+
 // contentContainsPattern checks if content contains the required pattern
 func (r *RequiredTextRule) contentContainsPattern(content string, pattern schema.RequiredTextPattern) bool {
 	// If literal is set (scalar form), use substring match
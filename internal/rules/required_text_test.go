@@ -259,3 +259,44 @@ func TestContentContainsPattern(t *testing.T) {
 		}
 	}
 }
+
+func TestRequiredTextRuleSectionSpan(t *testing.T) {
+	content := "# Title\n\n## Install\n\nSteps.\nMore steps.\n\n## Usage\n"
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	required := &schema.SectionRules{
+		RequiredText: []schema.RequiredTextPattern{{Literal: "go install"}},
+	}
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Pattern: "# Title"},
+				Children: []schema.StructureElement{
+					{Heading: schema.HeadingPattern{Pattern: "## Install"}, SectionRules: required},
+					{Heading: schema.HeadingPattern{Pattern: "## Usage"}, SectionRules: required},
+				},
+			},
+		},
+	}
+
+	violations := NewRequiredTextRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	want := []struct {
+		line int
+		span string
+	}{
+		{3, "(lines 5-6)"},
+		{8, "(section is empty)"},
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d: %+v", len(violations), len(want), violations)
+	}
+	for i, v := range violations {
+		if v.Line != want[i].line || !strings.HasSuffix(v.Message, want[i].span) {
+			t.Errorf("violation %d = %d:%d %q, want line %d ending %q", i, v.Line, v.Column, v.Message, want[i].line, want[i].span)
+		}
+	}
+}
@@ -48,14 +48,15 @@ func defaultStructuralRules() []StructuralRule {
 		NewListRule(),
 		NewWordCountRule(),
 		NewParagraphRule(),
+		NewHeadingRule(),
 	}
 }
 
 // defaultDocumentRules returns validation rules that operate at document level
 func defaultDocumentRules() []Rule {
 	return []Rule{
-		NewHeadingRule(),
 		NewLinkValidationRule(),
+		NewTerminologyRule(),
 	}
 }
 
@@ -66,7 +67,7 @@ func defaultRules() []Rule {
 		rules = append(rules, r)
 	}
 	rules = append(rules, defaultDocumentRules()...)
-	rules = append(rules, NewFrontmatterRule())
+	rules = append(rules, NewFrontmatterRule(), NewFrontmatterBodyRule())
 	return rules
 }
 
@@ -86,17 +87,74 @@ func (v *Validator) Validate(doc *parser.Document, s *schema.Schema, rootDir str
 	ctx := vast.NewContext(doc, s, rootDir)
 
 	for _, rule := range v.rules {
-		ruleViolations := rule.ValidateWithContext(ctx)
-		violations = append(violations, ruleViolations...)
+		level := s.Rules[rule.Name()]
+		// A rule turned off still runs for codes switched back on individually
+		if level == schema.RuleLevelOff && !hasEnabledCode(s.Rules, rule.Name()) {
+			continue
+		}
+
+		for _, violation := range rule.ValidateWithContext(ctx) {
+			// A level in the rules map overrides every severity set within the
+			// schema; a level for the violation's code wins over its rule's
+			effective := level
+			if codeLevel, ok := s.Rules[string(violation.Code)]; ok && violation.Code != "" {
+				effective = codeLevel
+			}
+			if effective == schema.RuleLevelOff {
+				continue
+			}
+			if effective != "" && effective.Valid() {
+				violation = violation.WithSeverity(severityFromSchema(string(effective)))
+			}
+			violations = append(violations, violation)
+		}
 	}
 
 	return violations
 }
 
+// hasEnabledCode reports whether the rules map gives any code of the named
+// rule a level other than off
+func hasEnabledCode(levels map[string]schema.RuleLevel, rule string) bool {
+	for _, info := range codeInfos {
+		if level, ok := levels[string(info.Code)]; ok && info.Rule == rule && level != schema.RuleLevelOff {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRuleKey reports whether key is a rule name or a check code (e.g. MDS102),
+// the keys accepted by the schema's rules map
+func IsRuleKey(key string) bool {
+	for _, name := range RuleNames() {
+		if name == key {
+			return true
+		}
+	}
+	for _, info := range codeInfos {
+		if string(info.Code) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// RuleNames returns the names of all validation rules, as used as keys in the
+// schema's rules map
+func RuleNames() []string {
+	names := make([]string, 0)
+	for _, rule := range defaultRules() {
+		names = append(names, rule.Name())
+	}
+	return names
+}
+
 // Generator creates markdown content using rules
 type Generator struct {
 	structuralRules      []StructuralRule
 	frontmatterGenerator FrontmatterGenerator
+	headingRule          *HeadingRule
 }
 
 // NewGenerator creates a generator that uses the same rules as the validator
@@ -104,6 +162,7 @@ func NewGenerator() *Generator {
 	return &Generator{
 		structuralRules:      defaultStructuralRules(),
 		frontmatterGenerator: NewFrontmatterRule(),
+		headingRule:          NewHeadingRule(),
 	}
 }
 
@@ -127,3 +186,8 @@ func (g *Generator) GenerateContent(builder *strings.Builder, element schema.Str
 func (g *Generator) GenerateFrontmatter(builder *strings.Builder, s *schema.Schema) {
 	g.frontmatterGenerator.Generate(builder, s)
 }
+
+// GenerateHeadingRules generates guidance for the document-wide heading rules
+func (g *Generator) GenerateHeadingRules(builder *strings.Builder, s *schema.Schema) {
+	g.headingRule.GenerateRules(builder, s)
+}
@@ -0,0 +1,108 @@
+package rules
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
+)
+
+func TestValidatorRuleLevels(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Title\n\n[broken](#nope)\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	newSchema := func(levels map[string]schema.RuleLevel) *schema.Schema {
+		return &schema.Schema{
+			Structure: []schema.StructureElement{
+				{
+					Heading:      schema.HeadingPattern{Literal: "# Title"},
+					SectionRules: &schema.SectionRules{Paragraphs: &schema.ParagraphRule{Min: 2}},
+				},
+			},
+			Links: &schema.LinkRule{ValidateInternal: true, Severity: "info"},
+			Rules: levels,
+		}
+	}
+
+	tests := []struct {
+		name   string
+		levels map[string]schema.RuleLevel
+		want   map[string]Severity
+	}{
+		{
+			name:   "no overrides",
+			levels: nil,
+			want:   map[string]Severity{"paragraph": SeverityError, "link": SeverityInfo},
+		},
+		{
+			name:   "rule turned off",
+			levels: map[string]schema.RuleLevel{"paragraph": schema.RuleLevelOff},
+			want:   map[string]Severity{"link": SeverityInfo},
+		},
+		{
+			name:   "severity overrides schema severity",
+			levels: map[string]schema.RuleLevel{"paragraph": schema.RuleLevelWarning, "link": schema.RuleLevelError},
+			want:   map[string]Severity{"paragraph": SeverityWarning, "link": SeverityError},
+		},
+		{
+			name:   "check turned off by code",
+			levels: map[string]schema.RuleLevel{string(CodeTooFewParagraphs): schema.RuleLevelOff},
+			want:   map[string]Severity{"link": SeverityInfo},
+		},
+		{
+			name:   "code level wins over rule level",
+			levels: map[string]schema.RuleLevel{"link": schema.RuleLevelError, string(CodeBrokenAnchor): schema.RuleLevelWarning},
+			want:   map[string]Severity{"paragraph": SeverityError, "link": SeverityWarning},
+		},
+		{
+			name:   "code level re-enables a check of a rule turned off",
+			levels: map[string]schema.RuleLevel{"link": schema.RuleLevelOff, string(CodeBrokenAnchor): schema.RuleLevelError},
+			want:   map[string]Severity{"paragraph": SeverityError, "link": SeverityError},
+		},
+		{
+			name:   "invalid level is ignored",
+			levels: map[string]schema.RuleLevel{"link": "loud"},
+			want:   map[string]Severity{"paragraph": SeverityError, "link": SeverityInfo},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations := NewValidator().Validate(doc, newSchema(tt.levels), "")
+
+			got := make(map[string]Severity)
+			for _, v := range violations {
+				got[v.Rule] = v.Severity
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got violations from %v, want %v", got, tt.want)
+			}
+			for rule, severity := range tt.want {
+				if got[rule] != severity {
+					t.Errorf("rule %q: Severity = %q, want %q", rule, got[rule], severity)
+				}
+			}
+		})
+	}
+}
+
+func TestIsRuleKey(t *testing.T) {
+	for key, want := range map[string]bool{"link": true, "MDS102": true, "out-of-order": false, "bogus": false} {
+		if got := IsRuleKey(key); got != want {
+			t.Errorf("IsRuleKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestRuleNames(t *testing.T) {
+	names := RuleNames()
+	for _, want := range []string{"structure", "paragraph", "link", "frontmatter", "heading"} {
+		if !slices.Contains(names, want) {
+			t.Errorf("RuleNames() = %v, missing %q", names, want)
+		}
+	}
+}
@@ -9,18 +9,6 @@ import (
 	"github.com/jackchuka/mdschema/internal/vast"
 )
 
-// severityFromSchema converts a schema severity string to rules.Severity
-func severityFromSchema(s string) Severity {
-	switch s {
-	case "warning":
-		return SeverityWarning
-	case "info":
-		return SeverityInfo
-	default:
-		return SeverityError
-	}
-}
-
 // StructureRule validates document structure using the hierarchical AST
 type StructureRule struct {
 	matcher *vast.PatternMatcher
@@ -48,6 +36,14 @@ func (r *StructureRule) ValidateWithContext(ctx *vast.Context) []Violation {
 
 	violations := make([]Violation, 0)
 
+	// Report heading expressions that failed to evaluate; they never match,
+	// which would otherwise surface only as missing or unexpected sections.
+	for _, e := range ctx.Tree.ExprErrors {
+		violations = append(violations,
+			NewViolation(r.Name(), fmt.Sprintf("Heading expression %q failed on %q: %v", e.Expr, e.Section.Heading.Text, e.Err), e.Section.Heading.Line, e.Section.Heading.Column).
+				WithCode(CodeHeadingExprError))
+	}
+
 	// Enforce that the first heading matches the first required element at each level.
 	violations = append(violations, r.validateFirstHeadingIssues(ctx)...)
 
@@ -68,7 +64,10 @@ func (r *StructureRule) ValidateWithContext(ctx *vast.Context) []Violation {
 
 			violations = append(violations,
 				NewViolation(r.Name(), fmt.Sprintf("Required element %q not found within %q", n.Element.Heading.GetReadableName(), parentName), line, col).
-					WithSeverity(severityFromSchema(n.Element.Severity)))
+					WithCode(CodeMissingSection).
+					WithSeverity(severityFromSchema(n.Element.Severity)).
+					WithMessage(n.Element.Message, MessageData{Heading: n.Element.Heading.GetReadableName(), Expected: parentName}).
+					WithHelpURL(n.Element.HelpURL))
 		}
 		return true
 	})
@@ -144,6 +143,7 @@ func (r *StructureRule) checkCountForElements(elements []schema.StructureElement
 
 		// Get a readable element name
 		elementName := element.Heading.GetReadableName()
+		data := MessageData{Heading: elementName, Found: count, Min: minMatches, Max: maxMatches}
 
 		// Check minimum constraint
 		if count < minMatches {
@@ -152,7 +152,10 @@ func (r *StructureRule) checkCountForElements(elements []schema.StructureElement
 					fmt.Sprintf("Element %q within %q requires at least %d occurrence(s), found %d",
 						elementName, parentName, minMatches, count),
 					1, 1).
-					WithSeverity(severityFromSchema(element.Severity)))
+					WithCode(CodeTooFewOccurrences).
+					WithSeverity(severityFromSchema(element.Severity)).
+					WithMessage(element.Message, data).
+					WithHelpURL(element.HelpURL))
 		}
 
 		// Check maximum constraint (0 means unlimited)
@@ -162,7 +165,10 @@ func (r *StructureRule) checkCountForElements(elements []schema.StructureElement
 					fmt.Sprintf("Element %q within %q allows at most %d occurrence(s), found %d",
 						elementName, parentName, maxMatches, count),
 					1, 1).
-					WithSeverity(severityFromSchema(element.Severity)))
+					WithCode(CodeTooManyOccurrences).
+					WithSeverity(severityFromSchema(element.Severity)).
+					WithMessage(element.Message, data).
+					WithHelpURL(element.HelpURL))
 		}
 	}
 
@@ -190,7 +196,7 @@ func (r *StructureRule) validateFirstHeadingIssues(ctx *vast.Context) []Violatio
 		if !firstExpected.Optional {
 			firstActual := ctx.Tree.Document.Root.Children[0]
 			if firstActual.Heading != nil {
-				if !r.matcher.MatchesHeading(firstActual.Heading, firstExpected.Heading, ctx.Tree.Document.Path) {
+				if !r.matcher.MatchesHeading(firstActual, firstExpected.Heading, ctx.Tree.Document, ctx.RootDir) {
 					actualHeading := strings.Repeat("#", firstActual.Heading.Level) + " " + firstActual.Heading.Text
 					var msg string
 					if firstExpected.Heading.Expr != "" {
@@ -204,7 +210,10 @@ func (r *StructureRule) validateFirstHeadingIssues(ctx *vast.Context) []Violatio
 					}
 					violations = append(violations,
 						NewViolation(r.Name(), msg, firstActual.Heading.Line, firstActual.Heading.Column).
-							WithSeverity(severityFromSchema(firstExpected.Severity)))
+							WithCode(CodeWrongFirstHeading).
+							WithSeverity(severityFromSchema(firstExpected.Severity)).
+							WithMessage(firstExpected.Message, MessageData{Heading: firstExpected.Heading.GetReadableName(), Found: actualHeading}).
+							WithHelpURL(firstExpected.HelpURL))
 				}
 			}
 		}
@@ -226,7 +235,7 @@ func (r *StructureRule) validateFirstHeadingIssues(ctx *vast.Context) []Violatio
 			return true
 		}
 
-		if !r.matcher.MatchesHeading(firstActual.Heading, firstExpected.Heading, ctx.Tree.Document.Path) {
+		if !r.matcher.MatchesHeading(firstActual, firstExpected.Heading, ctx.Tree.Document, ctx.RootDir) {
 			actualHeading := strings.Repeat("#", firstActual.Heading.Level) + " " + firstActual.Heading.Text
 			parentName := n.Section.Heading.Text
 			var msg string
@@ -240,7 +249,10 @@ func (r *StructureRule) validateFirstHeadingIssues(ctx *vast.Context) []Violatio
 			}
 			violations = append(violations,
 				NewViolation(r.Name(), msg, firstActual.Heading.Line, firstActual.Heading.Column).
-					WithSeverity(severityFromSchema(firstExpected.Severity)))
+					WithCode(CodeWrongFirstHeading).
+					WithSeverity(severityFromSchema(firstExpected.Severity)).
+					WithMessage(firstExpected.Message, MessageData{Heading: firstExpected.Heading.GetReadableName(), Found: actualHeading}).
+					WithHelpURL(firstExpected.HelpURL))
 		}
 
 		return true
@@ -271,7 +283,7 @@ func (r *StructureRule) validateUnmatchedSections(ctx *vast.Context) []Violation
 			parent = section.Parent.Heading.Text
 		}
 		violations = append(violations,
-			NewViolation(r.Name(), fmt.Sprintf("Unexpected section %q found under %q", heading, parent), section.Heading.Line, section.Heading.Column))
+			NewViolation(r.Name(), fmt.Sprintf("Unexpected section %q found under %q", heading, parent), section.Heading.Line, section.Heading.Column).WithCode(CodeUnexpectedSection))
 	}
 
 	return violations
@@ -316,12 +328,12 @@ func (r *StructureRule) validateOrderingIssues(ctx *vast.Context) []Violation {
 	violations := make([]Violation, 0)
 
 	// Check ordering at root level
-	violations = append(violations, r.checkSiblingOrder(ctx.Tree.Roots, ctx.Tree.Document.Root.Children, ctx.Tree.Document.Path)...)
+	violations = append(violations, r.checkSiblingOrder(ctx, ctx.Tree.Roots, ctx.Tree.Document.Root.Children)...)
 
 	// Check ordering within each bound node
 	ctx.Tree.WalkBound(func(n *vast.Node) bool {
 		if len(n.Children) > 0 && n.Section != nil {
-			violations = append(violations, r.checkSiblingOrder(n.Children, n.Section.Children, ctx.Tree.Document.Path)...)
+			violations = append(violations, r.checkSiblingOrder(ctx, n.Children, n.Section.Children)...)
 		}
 		return true
 	})
@@ -332,7 +344,7 @@ func (r *StructureRule) validateOrderingIssues(ctx *vast.Context) []Violation {
 // checkSiblingOrder checks if siblings appear in correct order.
 // It detects when an unbound node's pattern matches a section that appears
 // before a previously matched sibling (meaning it's out of order).
-func (r *StructureRule) checkSiblingOrder(siblings []*vast.Node, sections []*parser.Section, documentPath string) []Violation {
+func (r *StructureRule) checkSiblingOrder(ctx *vast.Context, siblings []*vast.Node, sections []*parser.Section) []Violation {
 	violations := make([]Violation, 0)
 
 	// Track the maximum line number seen so far among bound siblings
@@ -353,10 +365,13 @@ func (r *StructureRule) checkSiblingOrder(siblings []*vast.Node, sections []*par
 				if section.Heading == nil || section.StartLine >= maxBoundLine {
 					continue
 				}
-				if r.matcher.MatchesHeading(section.Heading, node.Element.Heading, documentPath) {
+				if r.matcher.MatchesHeading(section, node.Element.Heading, ctx.Tree.Document, ctx.RootDir) {
 					violations = append(violations,
 						NewViolation(r.Name(), fmt.Sprintf("Element %q should appear after %q but appears before it", section.Heading.Text, maxBoundText), section.Heading.Line, section.Heading.Column).
-							WithSeverity(severityFromSchema(node.Element.Severity)))
+							WithCode(CodeOutOfOrder).
+							WithSeverity(severityFromSchema(node.Element.Severity)).
+							WithMessage(node.Element.Message, MessageData{Heading: section.Heading.Text, Expected: maxBoundText}).
+							WithHelpURL(node.Element.HelpURL))
 					break
 				}
 			}
@@ -747,3 +747,100 @@ func TestStructureRuleExprExactMatch(t *testing.T) {
 		t.Errorf("Exact match should pass, got %d violations: %v", len(violations), violations)
 	}
 }
+
+func TestStructureRuleExprFrontmatter(t *testing.T) {
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{
+					Expr: "heading == fm.title",
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		content string
+		wantOK  bool
+	}{
+		{"matches title", "---\ntitle: Install Guide\n---\n\n# Install Guide\n", true},
+		{"differs from title", "---\ntitle: Install Guide\n---\n\n# Setup\n", false},
+		{"no frontmatter", "# Install Guide\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			doc, err := parser.New().Parse("guide.md", []byte(tt.content))
+			if err != nil {
+				t.Fatalf("Parse() error: %v", err)
+			}
+			violations := NewStructureRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if ok := len(violations) == 0; ok != tt.wantOK {
+				t.Errorf("passed = %v, want %v: %v", ok, tt.wantOK, violations)
+			}
+		})
+	}
+}
+
+func TestStructureRuleExprRuntimeError(t *testing.T) {
+	// version is a string, so the comparison fails on every heading; the
+	// failure is reported once instead of reading as "no match"
+	doc, err := parser.New().Parse("guide.md", []byte("---\nversion: \"2\"\n---\n\n# Guide\n\n# Usage\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{Heading: schema.HeadingPattern{Expr: "fm.version > 1"}, Count: &schema.CountConstraint{Min: 0}},
+		},
+	}
+
+	var exprErrors []Violation
+	for _, v := range NewStructureRule().ValidateWithContext(vast.NewContext(doc, s, "")) {
+		if v.Code == CodeHeadingExprError {
+			exprErrors = append(exprErrors, v)
+		}
+	}
+	if len(exprErrors) != 1 {
+		t.Fatalf("expected 1 %s violation, got %d: %v", CodeHeadingExprError, len(exprErrors), exprErrors)
+	}
+	if v := exprErrors[0]; v.Code != CodeHeadingExprError || v.Line != 5 {
+		t.Errorf("violation = %s at line %d, want %s at line 5: %s", v.Code, v.Line, CodeHeadingExprError, v.Message)
+	}
+}
+
+func TestStructureRuleCustomMessage(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Runbook\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Literal: "# Runbook"},
+				Children: []schema.StructureElement{
+					{
+						Heading: schema.HeadingPattern{Literal: "## Rollback"},
+						Message: "Every runbook needs a {{.Heading}} section",
+						HelpURL: "https://wiki.example.com/runbooks",
+					},
+				},
+			},
+		},
+	}
+
+	violations := NewStructureRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d: %v", len(violations), violations)
+	}
+	if want := "Every runbook needs a ## Rollback section"; violations[0].Message != want {
+		t.Errorf("Message = %q, want %q", violations[0].Message, want)
+	}
+	if violations[0].HelpURL != "https://wiki.example.com/runbooks" {
+		t.Errorf("HelpURL = %q", violations[0].HelpURL)
+	}
+}
@@ -2,8 +2,13 @@ package rules
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 	"github.com/jackchuka/mdschema/internal/vast"
 )
@@ -25,25 +30,27 @@ func (r *TableRule) Name() string {
 }
 
 // validateTableRequirement validates a specific table requirement for a node
-func (r *TableRule) validateTableRequirement(n *vast.Node, requirement schema.TableRule) []Violation {
+func (r *TableRule) validateTableRequirement(ctx *vast.Context, n *vast.Node, requirement schema.TableRule) []Violation {
 	violations := make([]Violation, 0)
 	tables := n.Tables()
 
 	line, col := n.Location()
+	opts := sectionReportOptions(requirement.Severity, requirement.Message, requirement.HelpURL, n.Element)
 
 	// Count tables
 	count := len(tables)
+	data := MessageData{Heading: n.HeadingText(), Found: count, Min: requirement.Min, Max: requirement.Max}
 
 	// Check minimum requirement
 	if requirement.Min > 0 && count < requirement.Min {
 		violations = append(violations,
-			NewViolation(r.Name(), fmt.Sprintf("Section '%s' requires at least %d tables, found %d", n.HeadingText(), requirement.Min, count), line, col))
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Section '%s' requires at least %d tables, found %d", n.HeadingText(), requirement.Min, count), line, col).WithCode(CodeTooFewTables), data))
 	}
 
 	// Check maximum requirement
 	if requirement.Max > 0 && count > requirement.Max {
 		violations = append(violations,
-			NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too many tables (max %d, found %d)", n.HeadingText(), requirement.Max, count), line, col))
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too many tables (max %d, found %d)", n.HeadingText(), requirement.Max, count), line, col).WithCode(CodeTooManyTables), data))
 	}
 
 	// Check minimum columns requirement
@@ -51,7 +58,8 @@ func (r *TableRule) validateTableRequirement(n *vast.Node, requirement schema.Ta
 		for _, table := range tables {
 			if len(table.Headers) < requirement.MinColumns {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has too few columns (minimum %d, found %d)", n.HeadingText(), requirement.MinColumns, len(table.Headers)), table.Line, table.Column))
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has too few columns (minimum %d, found %d)", n.HeadingText(), requirement.MinColumns, len(table.Headers)), table.Line, table.Column).WithCode(CodeTooFewTableColumns),
+						MessageData{Heading: n.HeadingText(), Found: len(table.Headers), Min: requirement.MinColumns}))
 			}
 		}
 	}
@@ -67,15 +75,280 @@ func (r *TableRule) validateTableRequirement(n *vast.Node, requirement schema.Ta
 			for _, required := range requirement.RequiredHeaders {
 				if !headerSet[strings.ToLower(required)] {
 					violations = append(violations,
-						NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' is missing required header '%s'", n.HeadingText(), required), table.Line, table.Column))
+						opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' is missing required header '%s'", n.HeadingText(), required), table.Line, table.Column).WithCode(CodeMissingTableHeader),
+							MessageData{Heading: n.HeadingText(), Found: strings.Join(table.Headers, ", "), Expected: required}))
+				}
+			}
+		}
+	}
+
+	for _, table := range tables {
+		violations = append(violations, r.validateTableContent(n, table, requirement, opts)...)
+		if requirement.MatchKeys != nil {
+			violations = append(violations, r.validateMatchKeys(ctx, n, table, *requirement.MatchKeys, opts)...)
+		}
+	}
+
+	return violations
+}
+
+// validateTableContent checks the header order and body rows of a table
+func (r *TableRule) validateTableContent(n *vast.Node, table *parser.Table, requirement schema.TableRule, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	heading := n.HeadingText()
+
+	rows := len(table.Rows)
+	data := MessageData{Heading: heading, Found: rows, Min: requirement.MinRows, Max: requirement.MaxRows}
+	if requirement.MinRows > 0 && rows < requirement.MinRows {
+		violations = append(violations,
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has too few rows (minimum %d, found %d)", heading, requirement.MinRows, rows), table.Line, table.Column).
+				WithCode(CodeTooFewTableRows), data))
+	}
+	if requirement.MaxRows > 0 && rows > requirement.MaxRows {
+		violations = append(violations,
+			opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has too many rows (max %d, found %d)", heading, requirement.MaxRows, rows), table.Line, table.Column).
+				WithCode(CodeTooManyTableRows), data))
+	}
+
+	// Position of each header, for order and column lookups
+	headerIndex := make(map[string]int)
+	for i, h := range table.Headers {
+		headerIndex[strings.ToLower(h)] = i
+	}
+
+	if requirement.HeadersInOrder {
+		// Only headers that are present are compared; missing ones are reported above
+		present := make([]string, 0, len(requirement.RequiredHeaders))
+		last := -1
+		inOrder := true
+		for _, required := range requirement.RequiredHeaders {
+			i, ok := headerIndex[strings.ToLower(required)]
+			if !ok {
+				continue
+			}
+			present = append(present, required)
+			if i < last {
+				inOrder = false
+			}
+			last = i
+		}
+		if !inOrder {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has headers out of order (expected %s, found %s)", heading, strings.Join(present, ", "), strings.Join(table.Headers, ", ")), table.Line, table.Column).
+					WithCode(CodeTableHeadersOutOfOrder),
+					MessageData{Heading: heading, Found: strings.Join(table.Headers, ", "), Expected: strings.Join(present, ", ")}))
+		}
+	}
+
+	if requirement.ExactHeaders {
+		for _, h := range table.Headers {
+			if !slices.ContainsFunc(requirement.RequiredHeaders, func(required string) bool { return strings.EqualFold(required, h) }) {
+				violations = append(violations,
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has unexpected header '%s' (allowed: %s)", heading, h, strings.Join(requirement.RequiredHeaders, ", ")), table.Line, table.Column).
+						WithCode(CodeUnexpectedTableHeader),
+						MessageData{Heading: heading, Found: h, Expected: strings.Join(requirement.RequiredHeaders, ", ")}))
+			}
+		}
+	}
+
+	// Column constraints, in header order so violations follow the table
+	type column struct {
+		index  int
+		header string
+		rule   schema.TableColumnRule
+	}
+	columns := make([]column, 0, len(requirement.Columns))
+	for name, rule := range requirement.Columns {
+		if i, ok := headerIndex[strings.ToLower(name)]; ok {
+			columns = append(columns, column{index: i, header: table.Headers[i], rule: rule})
+		}
+	}
+	slices.SortFunc(columns, func(a, b column) int { return a.index - b.index })
+
+	for _, row := range table.Rows {
+		for i, cell := range row.Cells {
+			if requirement.NoEmptyCells && cell == "" {
+				header := ""
+				if i < len(table.Headers) {
+					header = table.Headers[i]
+				}
+				violations = append(violations,
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has an empty '%s' cell", heading, header), row.Line, table.Column).
+						WithCode(CodeEmptyTableCell),
+						MessageData{Heading: heading, Field: header}))
+			}
+		}
+
+		for _, c := range columns {
+			if c.index >= len(row.Cells) {
+				continue
+			}
+			cell := row.Cells[c.index]
+			// Empty cells are no_empty_cells' concern, not a bad value
+			if cell == "" {
+				continue
+			}
+			if len(c.rule.Enum) > 0 && !slices.Contains(c.rule.Enum, cell) {
+				violations = append(violations,
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has invalid '%s' value '%s' (allowed: %s)", heading, c.header, cell, strings.Join(c.rule.Enum, ", ")), row.Line, table.Column).
+						WithCode(CodeInvalidTableCell),
+						MessageData{Heading: heading, Field: c.header, Found: cell, Expected: c.rule.Enum}))
+			}
+			if c.rule.Pattern != "" && indexTextPattern(cell, "", c.rule.Pattern) < 0 {
+				violations = append(violations,
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' has '%s' value '%s' not matching pattern '%s'", heading, c.header, cell, c.rule.Pattern), row.Line, table.Column).
+						WithCode(CodeInvalidTableCell),
+						MessageData{Heading: heading, Field: c.header, Found: cell, Expected: c.rule.Pattern}))
+			}
+		}
+	}
+
+	return violations
+}
+
+// docKey is a key from a code block or frontmatter array, positioned in the document
+type docKey struct {
+	path   string // Dot-notation path for nested keys
+	line   int
+	column int
+}
+
+// validateMatchKeys compares a table column with the keys of the section's
+// code blocks or a frontmatter array. Table rows without a matching key and
+// keys without a table row are both reported. Nothing is compared when the
+// section has no such code block or the frontmatter field is absent; a
+// frontmatter field that is not an array is reported once instead.
+func (r *TableRule) validateMatchKeys(ctx *vast.Context, n *vast.Node, table *parser.Table, match schema.TableKeysRule, opts reportOptions) []Violation {
+	violations := make([]Violation, 0)
+	heading := n.HeadingText()
+
+	column := slices.IndexFunc(table.Headers, func(h string) bool { return strings.EqualFold(h, match.Column) })
+	if column < 0 {
+		return violations
+	}
+
+	var keys []docKey
+	var source string
+	found := false
+	switch {
+	case match.CodeBlock != "":
+		source = fmt.Sprintf("%s code block", match.CodeBlock)
+		for _, block := range n.CodeBlocks() {
+			if ctx.Schema.CodeBlocks.SameLang(block.Lang, match.CodeBlock) {
+				found = true
+				keys = append(keys, codeBlockKeys(ctx.Schema.CodeBlocks.Canonical(block.Lang), block)...)
+			}
+		}
+	case match.Frontmatter != "":
+		source = fmt.Sprintf("frontmatter '%s'", match.Frontmatter)
+		if fm := ctx.Tree.Document.FrontMatter; fm != nil {
+			if value, ok := lookupField(fm.Data, match.Frontmatter); ok {
+				found = true
+				_, node, _ := fm.Lookup(splitFieldPath(match.Frontmatter))
+				items, isArray := value.([]any)
+				if !isArray {
+					// Without an array there is nothing to compare rows against
+					line, col := nodePosition(node)
+					violations = append(violations,
+						opts.apply(NewViolation(r.Name(), fmt.Sprintf("Frontmatter field '%s' should be an array to match the table in section '%s'", match.Frontmatter, heading), line, col).
+							WithCode(CodeTableKeysNotArray),
+							MessageData{Heading: heading, Field: match.Frontmatter, Expected: "array"}))
+					return violations
+				}
+				for i, item := range items {
+					line, col := nodePosition(node)
+					if node != nil && i < len(node.Content) {
+						line, col = nodePosition(node.Content[i])
+					}
+					keys = append(keys, docKey{path: fmt.Sprint(item), line: line, column: col})
 				}
 			}
 		}
 	}
+	if !found {
+		return violations
+	}
+
+	keySet := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		keySet[key.path] = true
+	}
+
+	documented := make(map[string]bool)
+	for _, row := range table.Rows {
+		if column >= len(row.Cells) || row.Cells[column] == "" {
+			continue
+		}
+		value := row.Cells[column]
+		documented[value] = true
+		if !keySet[value] {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("Table in section '%s' lists '%s' which is not in the %s", heading, value, source), row.Line, table.Column).
+					WithCode(CodeTableExtraEntry),
+					MessageData{Heading: heading, Field: table.Headers[column], Found: value}))
+		}
+	}
+
+	// Nested keys are covered when the table lists them or any key below them
+	for _, key := range keys {
+		if strings.Contains(key.path, ".") || documented[key.path] {
+			continue
+		}
+		covered := false
+		for value := range documented {
+			if strings.HasPrefix(value, key.path+".") {
+				covered = true
+				break
+			}
+		}
+		if !covered {
+			violations = append(violations,
+				opts.apply(NewViolation(r.Name(), fmt.Sprintf("'%s' from the %s is missing from the table in section '%s'", key.path, source, heading), key.line, key.column).
+					WithCode(CodeTableMissingEntry),
+					MessageData{Heading: heading, Field: table.Headers[column], Expected: key.path}))
+		}
+	}
 
 	return violations
 }
 
+// codeBlockKeys returns the mapping keys of a json, yaml or toml code block
+// as dot-notation paths in document order. Blocks that do not parse have no keys.
+func codeBlockKeys(lang string, block *parser.CodeBlock) []docKey {
+	var keys []docKey
+	switch strings.ToLower(lang) {
+	case "json", "yaml", "yml":
+		// JSON is a subset of YAML, and yaml.Node keeps key positions
+		var root yaml.Node
+		if err := yaml.Unmarshal([]byte(block.Content), &root); err != nil || len(root.Content) == 0 {
+			return nil
+		}
+		var walk func(node *yaml.Node, prefix string)
+		walk = func(node *yaml.Node, prefix string) {
+			if node.Kind != yaml.MappingNode {
+				return
+			}
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key := node.Content[i]
+				path := prefix + key.Value
+				keys = append(keys, docKey{path: path, line: block.Line + key.Line - 1, column: block.Column + key.Column - 1})
+				walk(node.Content[i+1], path+".")
+			}
+		}
+		walk(root.Content[0], "")
+	case "toml":
+		var v map[string]any
+		md, err := toml.Decode(block.Content, &v)
+		if err != nil {
+			return nil
+		}
+		for _, key := range md.Keys() {
+			keys = append(keys, docKey{path: strings.Join(key, "."), line: block.Line, column: block.Column})
+		}
+	}
+	return keys
+}
+
 // ValidateWithContext validates using VAST (validation-ready AST)
 func (r *TableRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	violations := make([]Violation, 0)
@@ -84,7 +357,7 @@ func (r *TableRule) ValidateWithContext(ctx *vast.Context) []Violation {
 	ctx.Tree.WalkBound(func(n *vast.Node) bool {
 		if n.Element.SectionRules != nil && len(n.Element.Tables) > 0 {
 			for _, requirement := range n.Element.Tables {
-				violations = append(violations, r.validateTableRequirement(n, requirement)...)
+				violations = append(violations, r.validateTableRequirement(ctx, n, requirement)...)
 			}
 		}
 		return true
@@ -114,6 +387,12 @@ func (r *TableRule) GenerateContent(builder *strings.Builder, element schema.Str
 		if len(rule.RequiredHeaders) > 0 {
 			fmt.Fprintf(builder, "<!-- Required headers: %s -->\n", strings.Join(rule.RequiredHeaders, ", "))
 		}
+		if rule.MinRows > 0 {
+			fmt.Fprintf(builder, "<!-- Minimum %d rows required -->\n", rule.MinRows)
+		}
+		if rule.MaxRows > 0 {
+			fmt.Fprintf(builder, "<!-- Maximum %d rows allowed -->\n", rule.MaxRows)
+		}
 	}
 	builder.WriteString("\n")
 
@@ -146,14 +425,22 @@ func (r *TableRule) GenerateContent(builder *strings.Builder, element schema.Str
 				}
 				builder.WriteString("\n")
 
-				// Write placeholder row
-				builder.WriteString("| ")
+				// Write placeholder rows, using the first allowed value for enum columns
 				placeholders := make([]string, len(headers))
-				for j := range placeholders {
+				for j, header := range headers {
 					placeholders[j] = "TODO"
+					for name, column := range rule.Columns {
+						if strings.EqualFold(name, header) && len(column.Enum) > 0 {
+							placeholders[j] = column.Enum[0]
+						}
+					}
 				}
-				builder.WriteString(strings.Join(placeholders, " | "))
-				builder.WriteString(" |\n\n")
+				for range max(1, rule.MinRows) {
+					builder.WriteString("| ")
+					builder.WriteString(strings.Join(placeholders, " | "))
+					builder.WriteString(" |\n")
+				}
+				builder.WriteString("\n")
 			}
 		}
 	}
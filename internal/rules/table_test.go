@@ -1,6 +1,7 @@
 package rules
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
@@ -260,3 +261,197 @@ func TestTableRuleGenerateContentNoRules(t *testing.T) {
 		t.Error("GenerateContent() should return false when no table rules")
 	}
 }
+
+func TestTableRuleContent(t *testing.T) {
+	// Header on line 3, body rows on lines 5-7
+	content := `# Options
+
+| Name | Type | Default |
+| --- | --- | --- |
+| ` + "`timeout`" + ` | int | 30 |
+| Verbose | boolean | |
+| retries | int | 3 |
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		rule      schema.TableRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{"rows within limits", schema.TableRule{MinRows: 3, MaxRows: 3}, nil, nil},
+		{"too few rows", schema.TableRule{MinRows: 4}, []Code{CodeTooFewTableRows}, []int{3}},
+		{"too many rows", schema.TableRule{MaxRows: 2}, []Code{CodeTooManyTableRows}, []int{3}},
+		{"headers in order", schema.TableRule{RequiredHeaders: []string{"Name", "Default"}, HeadersInOrder: true}, nil, nil},
+		{"headers out of order", schema.TableRule{RequiredHeaders: []string{"Type", "Name"}, HeadersInOrder: true}, []Code{CodeTableHeadersOutOfOrder}, []int{3}},
+		{"exact headers", schema.TableRule{RequiredHeaders: []string{"name", "type"}, ExactHeaders: true}, []Code{CodeUnexpectedTableHeader}, []int{3}},
+		{"no empty cells", schema.TableRule{NoEmptyCells: true}, []Code{CodeEmptyTableCell}, []int{6}},
+		{"column enum", schema.TableRule{Columns: map[string]schema.TableColumnRule{"type": {Enum: []string{"int", "bool"}}}}, []Code{CodeInvalidTableCell}, []int{6}},
+		{"column pattern", schema.TableRule{Columns: map[string]schema.TableColumnRule{"Name": {Pattern: "^[a-z_]+$"}}}, []Code{CodeInvalidTableCell}, []int{6}},
+		{"empty cell is not an invalid value", schema.TableRule{NoEmptyCells: true, Columns: map[string]schema.TableColumnRule{"Default": {Enum: []string{"30", "3"}}}}, []Code{CodeEmptyTableCell}, []int{6}},
+		{"empty cell without no_empty_cells", schema.TableRule{Columns: map[string]schema.TableColumnRule{"Default": {Pattern: "^[0-9]+$"}}}, nil, nil},
+		{"unknown column is ignored", schema.TableRule{Columns: map[string]schema.TableColumnRule{"Missing": {Enum: []string{"x"}}}}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading:      schema.HeadingPattern{Pattern: "# Options"},
+						SectionRules: &schema.SectionRules{Tables: []schema.TableRule{tt.rule}},
+					},
+				},
+			}
+
+			violations := NewTableRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i])
+				}
+			}
+		})
+	}
+}
+
+func TestTableRuleGenerateContentRows(t *testing.T) {
+	var builder strings.Builder
+	element := schema.StructureElement{
+		Heading: schema.HeadingPattern{Pattern: "## Options"},
+		SectionRules: &schema.SectionRules{
+			Tables: []schema.TableRule{{
+				Min:             1,
+				MinRows:         2,
+				RequiredHeaders: []string{"Name", "Type"},
+				Columns:         map[string]schema.TableColumnRule{"type": {Enum: []string{"string", "int"}}},
+			}},
+		},
+	}
+
+	NewTableRule().GenerateContent(&builder, element)
+
+	if got := strings.Count(builder.String(), "| TODO | string |"); got != 2 {
+		t.Errorf("expected 2 placeholder rows using the first enum value, got %d:\n%s", got, builder.String())
+	}
+}
+
+func TestTableRuleMatchKeys(t *testing.T) {
+	// Table rows on lines 12-14; yaml body starts on line 17
+	content := `---
+options: [timeout, retries]
+name: config
+---
+
+# Config
+
+## Options
+
+| Option | Description |
+| --- | --- |
+| ` + "`timeout`" + ` | Request timeout |
+| ` + "`server.port`" + ` | Listen port |
+| ` + "`verbose`" + ` | Removed option |
+
+` + "```yaml" + `
+timeout: 30
+server:
+  port: 8080
+retries: 3
+` + "```" + `
+`
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		match     schema.TableKeysRule
+		wantCodes []Code
+		wantLines []int
+	}{
+		{
+			name:      "code block keys",
+			match:     schema.TableKeysRule{Column: "option", CodeBlock: "yaml"},
+			wantCodes: []Code{CodeTableExtraEntry, CodeTableMissingEntry},
+			wantLines: []int{14, 20},
+		},
+		{
+			name:      "frontmatter array",
+			match:     schema.TableKeysRule{Column: "Option", Frontmatter: "options"},
+			wantCodes: []Code{CodeTableExtraEntry, CodeTableExtraEntry, CodeTableMissingEntry},
+			wantLines: []int{13, 14, 2},
+		},
+		{
+			name:      "frontmatter field is not an array",
+			match:     schema.TableKeysRule{Column: "Option", Frontmatter: "name"},
+			wantCodes: []Code{CodeTableKeysNotArray},
+			wantLines: []int{3},
+		},
+		{"no such code block", schema.TableKeysRule{Column: "Option", CodeBlock: "json"}, nil, nil},
+		{"no such column", schema.TableKeysRule{Column: "Name", CodeBlock: "yaml"}, nil, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &schema.Schema{
+				Structure: []schema.StructureElement{
+					{
+						Heading: schema.HeadingPattern{Pattern: "# Config"},
+						Children: []schema.StructureElement{
+							{
+								Heading: schema.HeadingPattern{Pattern: "## Options"},
+								SectionRules: &schema.SectionRules{
+									Tables: []schema.TableRule{{MatchKeys: &tt.match}},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			violations := NewTableRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+			if len(violations) != len(tt.wantCodes) {
+				t.Fatalf("expected %d violations, got %d: %v", len(tt.wantCodes), len(violations), violations)
+			}
+			for i, v := range violations {
+				if v.Code != tt.wantCodes[i] || v.Line != tt.wantLines[i] {
+					t.Errorf("violation %d = %s at line %d, want %s at line %d (%s)", i, v.Code, v.Line, tt.wantCodes[i], tt.wantLines[i], v.Message)
+				}
+			}
+		})
+	}
+}
+
+func TestCodeBlockKeys(t *testing.T) {
+	tests := []struct {
+		lang string
+		body string
+		want []string
+	}{
+		{"yaml", "a: 1\nb:\n  c: 2\n", []string{"a", "b", "b.c"}},
+		{"json", "{\"a\": 1, \"b\": {\"c\": 2}}\n", []string{"a", "b", "b.c"}},
+		{"toml", "a = 1\n[b]\nc = 2\n", []string{"a", "b", "b.c"}},
+		{"yaml", "- not a mapping\n", nil},
+		{"yaml", "a: [\n", nil},
+		{"bash", "a=1\n", nil},
+	}
+
+	for _, tt := range tests {
+		keys := codeBlockKeys(tt.lang, &parser.CodeBlock{Lang: tt.lang, Content: tt.body, Line: 1, Column: 1})
+		var got []string
+		for _, key := range keys {
+			got = append(got, key.path)
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("codeBlockKeys(%s, %q) = %v, want %v", tt.lang, tt.body, got, tt.want)
+		}
+	}
+}
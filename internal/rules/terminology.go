@@ -0,0 +1,195 @@
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/jackchuka/mdschema/internal/vast"
+	"github.com/yuin/goldmark/ast"
+)
+
+// TerminologyRule flags discouraged terms in prose and suggests their
+// preferred replacements
+type TerminologyRule struct {
+}
+
+var _ Rule = (*TerminologyRule)(nil)
+
+// NewTerminologyRule creates a new terminology rule
+func NewTerminologyRule() *TerminologyRule {
+	return &TerminologyRule{}
+}
+
+// Name returns the rule identifier
+func (r *TerminologyRule) Name() string {
+	return "terminology"
+}
+
+// textRun is a stretch of prose with its byte offset in the document
+type textRun struct {
+	start int
+	text  string
+}
+
+// termMatch is one occurrence of a discouraged term
+type termMatch struct {
+	start, end  int
+	found       string
+	replacement string
+}
+
+// ValidateWithContext reports every discouraged term outside code and URLs
+func (r *TerminologyRule) ValidateWithContext(ctx *vast.Context) []Violation {
+	violations := make([]Violation, 0)
+
+	cfg := ctx.Schema.Terminology
+	if cfg == nil || len(cfg.Terms) == 0 || ctx.Tree.Document.AST == nil {
+		return violations
+	}
+
+	opts := reportOptions{
+		severity: severityFromSchema(cfg.Severity),
+		message:  cfg.Message,
+		helpURL:  cfg.HelpURL,
+	}
+
+	content := ctx.Tree.Document.Content
+	text := string(content)
+	matches := findTerms(proseRuns(ctx.Tree.Document.AST, content), cfg.Terms, cfg.CaseSensitive)
+	for _, m := range matches {
+		line, col := offsetPosition(text, m.start)
+		v := NewViolation(r.Name(),
+			fmt.Sprintf("Use '%s' instead of '%s'", m.replacement, m.found),
+			line, col).WithCode(CodeDiscouragedTerm).WithFix(m.start, m.end, m.replacement)
+		violations = append(violations, opts.apply(v, MessageData{Found: m.found, Expected: m.replacement}))
+	}
+
+	return violations
+}
+
+// proseRuns collects the text of the document, skipping code spans, code
+// blocks, raw HTML, and autolinks. Adjacent text nodes are merged so words
+// split by the inline parser are matched whole. Link text is included; link
+// destinations are not text nodes and so are never checked.
+func proseRuns(root ast.Node, content []byte) []textRun {
+	runs := make([]textRun, 0)
+	last := -1
+	_ = ast.Walk(root, func(n ast.Node, entering bool) (ast.WalkStatus, error) {
+		if !entering {
+			return ast.WalkContinue, nil
+		}
+		switch node := n.(type) {
+		case *ast.CodeSpan, *ast.CodeBlock, *ast.FencedCodeBlock,
+			*ast.HTMLBlock, *ast.RawHTML, *ast.AutoLink:
+			last = -1
+			return ast.WalkSkipChildren, nil
+		case *ast.Text:
+			seg := node.Segment
+			text := string(seg.Value(content))
+			if last >= 0 && runs[last].start+len(runs[last].text) == seg.Start {
+				runs[last].text += text
+			} else {
+				runs = append(runs, textRun{start: seg.Start, text: text})
+				last = len(runs) - 1
+			}
+			if node.SoftLineBreak() || node.HardLineBreak() {
+				last = -1
+			}
+		}
+		return ast.WalkContinue, nil
+	})
+	return runs
+}
+
+// findTerms returns the discouraged term occurrences in document order.
+// Longer terms are matched first so "e-mail address" wins over "e-mail".
+func findTerms(runs []textRun, terms map[string]string, caseSensitive bool) []termMatch {
+	keys := make([]string, 0, len(terms))
+	for term := range terms {
+		if strings.TrimSpace(term) != "" {
+			keys = append(keys, term)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	matches := make([]termMatch, 0)
+	for _, term := range keys {
+		expr := regexp.QuoteMeta(term)
+		if !caseSensitive {
+			expr = "(?i)" + expr
+		}
+		re := regexp.MustCompile(expr)
+		preferred := terms[term]
+
+		for _, run := range runs {
+			for _, loc := range re.FindAllStringIndex(run.text, -1) {
+				found := run.text[loc[0]:loc[1]]
+				if found == preferred || !isWordBoundary(run.text, loc[0], loc[1]) {
+					continue
+				}
+				m := termMatch{
+					start:       run.start + loc[0],
+					end:         run.start + loc[1],
+					found:       found,
+					replacement: matchCase(found, preferred),
+				}
+				if !overlapsMatch(matches, m) {
+					matches = append(matches, m)
+				}
+			}
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool { return matches[i].start < matches[j].start })
+	return matches
+}
+
+// isWordBoundary reports whether text[start:end] is not part of a larger word
+func isWordBoundary(text string, start, end int) bool {
+	if before, _ := utf8.DecodeLastRuneInString(text[:start]); start > 0 && isWordRune(before) {
+		return false
+	}
+	if after, _ := utf8.DecodeRuneInString(text[end:]); end < len(text) && isWordRune(after) {
+		return false
+	}
+	return true
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+func overlapsMatch(matches []termMatch, m termMatch) bool {
+	for _, other := range matches {
+		if m.start < other.end && other.start < m.end {
+			return true
+		}
+	}
+	return false
+}
+
+// matchCase adapts an all-lowercase replacement to the case of the
+// occurrence it replaces ("Whitelist" -> "Allowlist"). Replacements with
+// their own capitalization are returned unchanged.
+func matchCase(found, preferred string) string {
+	if preferred != strings.ToLower(preferred) {
+		return preferred
+	}
+	if strings.ToUpper(found) == found && strings.ToLower(found) != found && utf8.RuneCountInString(found) > 1 {
+		return strings.ToUpper(preferred)
+	}
+	if first, _ := utf8.DecodeRuneInString(found); unicode.IsUpper(first) {
+		r, size := utf8.DecodeRuneInString(preferred)
+		return string(unicode.ToUpper(r)) + preferred[size:]
+	}
+	return preferred
+}
@@ -0,0 +1,109 @@
+package rules
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jackchuka/mdschema/internal/parser"
+	"github.com/jackchuka/mdschema/internal/schema"
+	"github.com/jackchuka/mdschema/internal/vast"
+)
+
+func TestTerminologyRule(t *testing.T) {
+	content := "# Guide\n" +
+		"\n" +
+		"Add the host to the Whitelist, then send an e-mail.\n" +
+		"\n" +
+		"Code like `whitelist()` and [docs](https://example.com/whitelist) are fine,\n" +
+		"but [the WHITELIST page](#guide) is not. Whitelisting is a different word.\n" +
+		"\n" +
+		"```sh\n" +
+		"echo whitelist\n" +
+		"```\n" +
+		"\n" +
+		"We write JavaScript, not Javascript.\n"
+
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	s := &schema.Schema{
+		Terminology: &schema.TerminologyConfig{
+			Terms: map[string]string{
+				"whitelist":  "allowlist",
+				"e-mail":     "email",
+				"javascript": "JavaScript",
+			},
+		},
+	}
+
+	violations := NewTerminologyRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	want := []string{
+		"3:21 Use 'Allowlist' instead of 'Whitelist'",
+		"3:45 Use 'email' instead of 'e-mail'",
+		"6:10 Use 'ALLOWLIST' instead of 'WHITELIST'",
+		"12:26 Use 'JavaScript' instead of 'Javascript'",
+	}
+	if len(violations) != len(want) {
+		t.Fatalf("got %d violations, want %d: %+v", len(violations), len(want), violations)
+	}
+	for i, v := range violations {
+		got := fmt.Sprintf("%d:%d %s", v.Line, v.Column, v.Message)
+		if got != want[i] {
+			t.Errorf("violation %d = %q, want %q", i, got, want[i])
+		}
+		if v.Code != CodeDiscouragedTerm {
+			t.Errorf("violation %d code = %s, want %s", i, v.Code, CodeDiscouragedTerm)
+		}
+		// The fix replaces exactly the flagged occurrence
+		if v.Fix == nil {
+			t.Errorf("violation %d has no fix", i)
+			continue
+		}
+		if got, want := fmt.Sprintf("Use '%s' instead of '%s'", v.Fix.Replacement, content[v.Fix.Start:v.Fix.End]), v.Message; got != want {
+			t.Errorf("violation %d fix = %q, want it to match %q", i, got, want)
+		}
+	}
+}
+
+func TestTerminologyRuleCaseSensitive(t *testing.T) {
+	doc, err := parser.New().Parse("test.md", []byte("# Title\n\nSee the Master branch and the master branch.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	s := &schema.Schema{
+		Terminology: &schema.TerminologyConfig{
+			Terms:         map[string]string{"master branch": "main branch"},
+			CaseSensitive: true,
+			Severity:      "warning",
+			Message:       "Prefer {{.Expected}}",
+		},
+	}
+
+	violations := NewTerminologyRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1: %+v", len(violations), violations)
+	}
+	v := violations[0]
+	if v.Line != 3 || v.Column != 31 {
+		t.Errorf("position = %d:%d, want 3:31", v.Line, v.Column)
+	}
+	if v.Message != "Prefer main branch" {
+		t.Errorf("message = %q, want %q", v.Message, "Prefer main branch")
+	}
+	if v.Severity != SeverityWarning {
+		t.Errorf("severity = %s, want %s", v.Severity, SeverityWarning)
+	}
+}
+
+func TestTerminologyRuleNotConfigured(t *testing.T) {
+	doc, err := parser.New().Parse("test.md", []byte("# Title\n\nwhitelist\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+	violations := NewTerminologyRule().ValidateWithContext(vast.NewContext(doc, &schema.Schema{}, ""))
+	if len(violations) != 0 {
+		t.Errorf("got %d violations, want 0", len(violations))
+	}
+}
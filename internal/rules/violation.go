@@ -1,6 +1,11 @@
 package rules
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/jackchuka/mdschema/internal/schema"
+)
 
 // Severity levels for violations
 type Severity string
@@ -11,14 +16,87 @@ const (
 	SeverityInfo    Severity = "info"
 )
 
+// severityFromSchema converts a schema severity string to rules.Severity
+func severityFromSchema(s string) Severity {
+	switch s {
+	case "warning":
+		return SeverityWarning
+	case "info":
+		return SeverityInfo
+	default:
+		return SeverityError
+	}
+}
+
+// ruleSeverity resolves the severity of a rule that may override a fallback
+// (e.g. a section rule inside a structure element). The rule's own severity
+// wins, then the fallback, then error.
+func ruleSeverity(severity, fallback string) Severity {
+	if severity != "" {
+		return severityFromSchema(severity)
+	}
+	return severityFromSchema(fallback)
+}
+
+// reportOptions are the reporting settings a schema rule can carry: severity,
+// custom message template, and help URL.
+type reportOptions struct {
+	severity Severity
+	message  string
+	helpURL  string
+}
+
+// sectionReportOptions resolves the reporting settings of a section rule. The
+// severity and help URL fall back to the enclosing structure element's; the
+// element's message template only applies to structure violations.
+func sectionReportOptions(severity, message, helpURL string, element schema.StructureElement) reportOptions {
+	if helpURL == "" {
+		helpURL = element.HelpURL
+	}
+	return reportOptions{
+		severity: ruleSeverity(severity, element.Severity),
+		message:  message,
+		helpURL:  helpURL,
+	}
+}
+
+// apply finishes a violation with the configured severity, message and help URL
+func (o reportOptions) apply(v Violation, data MessageData) Violation {
+	return v.WithSeverity(o.severity).WithMessage(o.message, data).WithHelpURL(o.helpURL)
+}
+
 // Violation represents a rule violation
 type Violation struct {
 	Rule     string
+	Code     Code // Stable identifier of the specific check, e.g. MDS102
 	Message  string
 	Path     string
 	Line     int
 	Column   int
 	Severity Severity
+	HelpURL  string // Link to documentation explaining the rule, if configured
+	Fix      *Fix   // Suggested edit that resolves the violation, if the rule knows one
+}
+
+// Fix is a suggested edit to the document: replace the bytes in
+// [Start, End) with Replacement
+type Fix struct {
+	Start       int // Byte offset of the first byte to replace
+	End         int // Byte offset just past the last byte to replace
+	Replacement string
+}
+
+// MessageData holds the values available to custom message templates
+// (e.g. "Section {{.Heading}} needs {{.Min}} examples, found {{.Found}}").
+// Fields that do not apply to a check are left at their zero value.
+type MessageData struct {
+	Heading  string // Heading text of the section, or the expected heading for missing sections
+	Found    any    // What the document contains: a count, a value, or the matched text
+	Expected any    // What the rule expects: a pattern, language, header, or value
+	Min      int    // Configured minimum
+	Max      int    // Configured maximum
+	Field    string // Frontmatter field name
+	Default  string // The rule's built-in message
 }
 
 // NewViolation creates a violation with default severity (error)
@@ -32,12 +110,54 @@ func NewViolation(rule, message string, line, column int) Violation {
 	}
 }
 
+// WithCode returns a copy of the violation identified by the given check code
+func (v Violation) WithCode(code Code) Violation {
+	v.Code = code
+	return v
+}
+
+// WithFix returns a copy of the violation suggesting that the document bytes
+// in [start, end) be replaced with replacement
+func (v Violation) WithFix(start, end int, replacement string) Violation {
+	v.Fix = &Fix{Start: start, End: end, Replacement: replacement}
+	return v
+}
+
 // WithSeverity returns a copy of the violation with the specified severity
 func (v Violation) WithSeverity(s Severity) Violation {
 	v.Severity = s
 	return v
 }
 
+// WithMessage returns a copy of the violation with its message replaced by the
+// rendered template. An empty template, or one that fails to render, keeps the
+// built-in message.
+func (v Violation) WithMessage(tmpl string, data MessageData) Violation {
+	if tmpl == "" {
+		return v
+	}
+	data.Default = v.Message
+	t, err := schema.MessageTemplate(tmpl)
+	if err != nil {
+		return v
+	}
+	var b strings.Builder
+	if err := t.Execute(&b, data); err != nil {
+		return v
+	}
+	v.Message = b.String()
+	return v
+}
+
+// WithHelpURL returns a copy of the violation linking to the given documentation.
+// An empty URL leaves the violation unchanged.
+func (v Violation) WithHelpURL(url string) Violation {
+	if url != "" {
+		v.HelpURL = url
+	}
+	return v
+}
+
 func (v Violation) WithPath(path string) Violation {
 	v.Path = path
 	return v
@@ -0,0 +1,40 @@
+package rules
+
+import "testing"
+
+func TestViolationWithMessage(t *testing.T) {
+	v := NewViolation("wordcount", "Section 'Usage' has too few words (minimum 50, found 12)", 3, 1)
+	data := MessageData{Heading: "Usage", Found: 12, Min: 50}
+
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{"empty template keeps message", "", v.Message},
+		{"placeholders", "{{.Heading}} needs {{.Min}} words, has {{.Found}}", "Usage needs 50 words, has 12"},
+		{"default message", "{{.Default}} - see the style guide", v.Message + " - see the style guide"},
+		{"unknown field keeps message", "{{.Nope}}", v.Message},
+		{"invalid template keeps message", "{{.Heading", v.Message},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := v.WithMessage(tt.template, data)
+			if got.Message != tt.want {
+				t.Errorf("Message = %q, want %q", got.Message, tt.want)
+			}
+		})
+	}
+}
+
+func TestViolationWithHelpURL(t *testing.T) {
+	v := NewViolation("structure", "missing", 1, 1)
+
+	if got := v.WithHelpURL("").HelpURL; got != "" {
+		t.Errorf("HelpURL = %q, want empty", got)
+	}
+	if got := v.WithHelpURL("https://example.com/docs").HelpURL; got != "https://example.com/docs" {
+		t.Errorf("HelpURL = %q, want %q", got, "https://example.com/docs")
+	}
+}
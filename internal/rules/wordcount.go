@@ -60,17 +60,19 @@ func (r *WordCountRule) ValidateWithContext(ctx *vast.Context) []Violation {
 			rule := n.Element.WordCount
 			wordCount := r.countWords(n.Content())
 			line, col := n.Location()
+			opts := sectionReportOptions(rule.Severity, rule.Message, rule.HelpURL, n.Element)
+			data := MessageData{Heading: n.HeadingText(), Found: wordCount, Min: rule.Min, Max: rule.Max}
 
 			// Check minimum requirement
 			if rule.Min > 0 && wordCount < rule.Min {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too few words (minimum %d, found %d)", n.HeadingText(), rule.Min, wordCount), line, col))
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too few words (minimum %d, found %d)", n.HeadingText(), rule.Min, wordCount), line, col).WithCode(CodeTooFewWords), data))
 			}
 
 			// Check maximum requirement
 			if rule.Max > 0 && wordCount > rule.Max {
 				violations = append(violations,
-					NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too many words (maximum %d, found %d)", n.HeadingText(), rule.Max, wordCount), line, col))
+					opts.apply(NewViolation(r.Name(), fmt.Sprintf("Section '%s' has too many words (maximum %d, found %d)", n.HeadingText(), rule.Max, wordCount), line, col).WithCode(CodeTooManyWords), data))
 			}
 		}
 		return true
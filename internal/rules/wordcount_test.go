@@ -193,3 +193,39 @@ func TestWordCountRuleGenerateContentNoRules(t *testing.T) {
 		t.Error("GenerateContent() should return false when no word count rules")
 	}
 }
+
+func TestWordCountRuleCustomMessage(t *testing.T) {
+	p := parser.New()
+	doc, err := p.Parse("test.md", []byte("# Usage\n\nRun it.\n"))
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Pattern: "# Usage"},
+				HelpURL: "https://example.com/style#usage",
+				SectionRules: &schema.SectionRules{
+					WordCount: &schema.WordCountRule{
+						Min:     10,
+						Message: "{{.Heading}} should explain usage in at least {{.Min}} words (found {{.Found}})",
+					},
+				},
+			},
+		},
+	}
+
+	violations := NewWordCountRule().ValidateWithContext(vast.NewContext(doc, s, ""))
+
+	if len(violations) != 1 {
+		t.Fatalf("expected 1 violation, got %d", len(violations))
+	}
+	if want := "Usage should explain usage in at least 10 words (found 2)"; violations[0].Message != want {
+		t.Errorf("Message = %q, want %q", violations[0].Message, want)
+	}
+	// The section rule inherits the element's help URL
+	if violations[0].HelpURL != "https://example.com/style#usage" {
+		t.Errorf("HelpURL = %q", violations[0].HelpURL)
+	}
+}
@@ -119,3 +119,28 @@ More
 		t.Fatalf("expected child heading '## Child (Optional)', got %#v", got.Structure[0].Children)
 	}
 }
+
+func TestFromDocumentSkipsTOMLFrontMatter(t *testing.T) {
+	// Without stripping, the TOML comment would be inferred as a heading
+	content := `+++
+# Site settings
+title = "Example"
++++
+
+# First
+`
+
+	doc, err := parser.New().Parse("test.md", []byte(content))
+	if err != nil {
+		t.Fatalf("parse markdown: %v", err)
+	}
+
+	got, err := FromDocument(doc)
+	if err != nil {
+		t.Fatalf("FromDocument returned error: %v", err)
+	}
+
+	if len(got.Structure) != 1 || got.Structure[0].Heading.Pattern != "# First" {
+		t.Fatalf("expected only '# First', got %#v", got.Structure)
+	}
+}
@@ -30,6 +30,14 @@ func simpleLoadYAML(path string) (*Schema, []Warning, error) {
 		return nil, nil, fmt.Errorf("checking schema keys: %w", err)
 	}
 
+	if err := checkExpressions(data); err != nil {
+		return nil, nil, fmt.Errorf("checking schema expressions: %w", err)
+	}
+
+	if err := schema.CodeBlocks.CheckAliases(); err != nil {
+		return nil, nil, fmt.Errorf("checking code block aliases: %w", err)
+	}
+
 	return &schema, warnings, nil
 }
 
@@ -3,6 +3,7 @@ package schema
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -78,6 +79,191 @@ func TestLoadSchemaWithRules(t *testing.T) {
 	}
 }
 
+func TestLoadSchemaWithRuleSeverity(t *testing.T) {
+	tmpDir := t.TempDir()
+	schemaFile := filepath.Join(tmpDir, "schema.yml")
+
+	content := []byte(`structure:
+  - heading: "# Title"
+    word_count: { min: 50, severity: warning }
+    code_blocks:
+      - { lang: go, min: 1, severity: info }
+links:
+  validate_internal: true
+  severity: warning
+`)
+	if err := os.WriteFile(schemaFile, content, 0o644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	schema, _, err := Load(schemaFile)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	rules := schema.Structure[0].SectionRules
+	if rules == nil || rules.WordCount == nil || len(rules.CodeBlocks) != 1 {
+		t.Fatalf("expected word_count and code_blocks rules, got %+v", rules)
+	}
+	if rules.WordCount.Severity != "warning" {
+		t.Errorf("WordCount.Severity = %q, want %q", rules.WordCount.Severity, "warning")
+	}
+	if rules.CodeBlocks[0].Severity != "info" {
+		t.Errorf("CodeBlocks[0].Severity = %q, want %q", rules.CodeBlocks[0].Severity, "info")
+	}
+	if schema.Links.Severity != "warning" {
+		t.Errorf("Links.Severity = %q, want %q", schema.Links.Severity, "warning")
+	}
+}
+
+func TestLoadSchemaImageFileSize(t *testing.T) {
+	tmpDir := t.TempDir()
+	schemaFile := filepath.Join(tmpDir, "schema.yml")
+
+	content := []byte(`structure:
+  - heading: "## Screenshots"
+    images:
+      - {max_file_size: 500KB}
+      - {max_file_size: 2048}
+      - {max_file_size: 1.5 MB}
+`)
+	if err := os.WriteFile(schemaFile, content, 0o644); err != nil {
+		t.Fatalf("failed to create test file: %v", err)
+	}
+
+	schema, _, err := Load(schemaFile)
+	if err != nil {
+		t.Fatalf("Load() error: %v", err)
+	}
+
+	want := []FileSize{500 << 10, 2048, 3 << 19}
+	for i, rule := range schema.Structure[0].Images {
+		if rule.MaxFileSize != want[i] {
+			t.Errorf("Images[%d].MaxFileSize = %d, want %d", i, rule.MaxFileSize, want[i])
+		}
+	}
+
+	if got := FileSize(500 << 10).String(); got != "500KB" {
+		t.Errorf("FileSize.String() = %q, want %q", got, "500KB")
+	}
+
+	if _, err := ParseFileSize("big"); err == nil {
+		t.Error("ParseFileSize(\"big\") should fail")
+	}
+}
+
+func TestLoadSchemaExpressions(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string // Substring of the error, "" for none
+	}{
+		{
+			name: "valid",
+			content: `structure:
+  - heading:
+      expr: "slug(filename) == slug(heading) && semver(fm.version ?? \"\") == false"
+frontmatter:
+  assertions:
+    - expr: "updated == nil || updated >= date"
+`,
+		},
+		{
+			name: "unknown function in nested heading",
+			content: `structure:
+  - heading: "# Title"
+    children:
+      - heading:
+          expr: "titlecase(heading) == heading"
+`,
+			wantErr: "line 5: invalid expr",
+		},
+		{
+			name: "assertion syntax error",
+			content: `frontmatter:
+  assertions:
+    - expr: "updated >="
+`,
+			wantErr: "line 3: invalid expr",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemaFile := filepath.Join(t.TempDir(), "schema.yml")
+			if err := os.WriteFile(schemaFile, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to create test file: %v", err)
+			}
+
+			_, _, err := Load(schemaFile)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Load() error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLoadSchemaCodeBlockAliases(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string // Substring of the error, "" for none
+	}{
+		{
+			name: "distinct aliases",
+			content: `code_blocks:
+  aliases:
+    bash: [sh, shell]
+    javascript: [js]
+`,
+		},
+		{
+			name: "synonym under two names",
+			content: `code_blocks:
+  aliases:
+    bash: [sh, shell]
+    zsh: [Shell]
+`,
+			wantErr: `language "Shell" is an alias of both "bash" and "zsh"`,
+		},
+		{
+			name: "canonical name used as synonym",
+			content: `code_blocks:
+  aliases:
+    bash: [sh]
+    sh: [dash]
+`,
+			wantErr: `language "sh" is an alias of both "sh" and "bash"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schemaFile := filepath.Join(t.TempDir(), "schema.yml")
+			if err := os.WriteFile(schemaFile, []byte(tt.content), 0o644); err != nil {
+				t.Fatalf("failed to create test file: %v", err)
+			}
+
+			_, _, err := Load(schemaFile)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Load() error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Load() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestLoadSchemaWithChildren(t *testing.T) {
 	tmpDir := t.TempDir()
 	schemaFile := filepath.Join(tmpDir, "schema.yml")
@@ -0,0 +1,32 @@
+package schema
+
+import (
+	"sync"
+	"text/template"
+)
+
+// parsedTemplate is a message template and the error from parsing it
+type parsedTemplate struct {
+	tmpl *template.Template
+	err  error
+}
+
+var (
+	messageMu        sync.Mutex
+	messageTemplates = make(map[string]parsedTemplate)
+)
+
+// MessageTemplate returns the parsed form of a custom `message:` template.
+// Each distinct text is parsed once: the schema loader parses every template
+// while checking it, and rules reuse the result for each violation. Missing
+// keys are errors, so a misspelled field keeps the built-in message.
+func MessageTemplate(text string) (*template.Template, error) {
+	messageMu.Lock()
+	defer messageMu.Unlock()
+	if parsed, ok := messageTemplates[text]; ok {
+		return parsed.tmpl, parsed.err
+	}
+	tmpl, err := template.New("message").Option("missingkey=error").Parse(text)
+	messageTemplates[text] = parsedTemplate{tmpl: tmpl, err: err}
+	return tmpl, err
+}
@@ -1,6 +1,13 @@
 package schema
 
 import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
 	"github.com/invopop/jsonschema"
 	orderedmap "github.com/pb33f/ordered-map/v2"
 	"gopkg.in/yaml.v3"
@@ -17,8 +24,69 @@ type Schema struct {
 	// Global heading validation rules
 	HeadingRules *HeadingRules `yaml:"heading_rules,omitempty" json:"heading_rules,omitempty" hc:"Global heading validation rules"`
 
+	// Global code block settings
+	CodeBlocks *CodeBlocksConfig `yaml:"code_blocks,omitempty" json:"code_blocks,omitempty" hc:"Global code block settings"`
+
+	// Global terminology rules
+	Terminology *TerminologyConfig `yaml:"terminology,omitempty" json:"terminology,omitempty" hc:"Discouraged terms and their preferred replacements"`
+
 	// Frontmatter validation rules
 	Frontmatter *FrontmatterConfig `yaml:"frontmatter,omitempty" json:"frontmatter,omitempty" hc:"YAML frontmatter validation"`
+
+	// Rules turns rules off or overrides their severity, keyed by rule name or
+	// check code (e.g. "paragraph: off", "link: warning", "MDS102: info")
+	Rules map[string]RuleLevel `yaml:"rules,omitempty" json:"rules,omitempty" hc:"Turn rules off or override their severity by rule name or check code"`
+}
+
+// RuleLevel configures a rule in the top-level rules map
+type RuleLevel string
+
+// Rule level constants
+const (
+	RuleLevelOff     RuleLevel = "off"     // rule does not run
+	RuleLevelInfo    RuleLevel = "info"    // all violations reported as info
+	RuleLevelWarning RuleLevel = "warning" // all violations reported as warnings
+	RuleLevelError   RuleLevel = "error"   // all violations reported as errors
+)
+
+// JSONSchema implements jsonschema.JSONSchemer to add enum constraint
+func (RuleLevel) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "string",
+		Enum:        []any{"off", "info", "warning", "error"},
+		Description: "Rule level: off, info, warning, or error",
+	}
+}
+
+// Valid reports whether l is one of the RuleLevel constants
+func (l RuleLevel) Valid() bool {
+	switch l {
+	case RuleLevelOff, RuleLevelInfo, RuleLevelWarning, RuleLevelError:
+		return true
+	}
+	return false
+}
+
+// TerminologyConfig lists discouraged terms with their preferred
+// replacements. Terms are matched as whole words in prose only: code spans,
+// code blocks, and link URLs are skipped.
+type TerminologyConfig struct {
+	// Terms maps each discouraged term to its preferred replacement
+	Terms map[string]string `yaml:"terms" json:"terms" lc:"discouraged term to preferred replacement (e.g. 'e-mail: email')"`
+
+	// CaseSensitive matches terms only in the given case. By default any case
+	// matches, except for the preferred spelling itself (so "Javascript:
+	// JavaScript" flags "javascript" but not "JavaScript").
+	CaseSensitive bool `yaml:"case_sensitive,omitempty" json:"case_sensitive,omitempty" lc:"match terms only in the given case"`
+
+	// Severity level for terminology violations (error, warning, info). Default: error
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "Use {{.Expected}} instead of {{.Found}}")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Use {{.Expected}} instead of {{.Found}}')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations"`
 }
 
 // LinkRule defines validation rules for links in the document
@@ -40,6 +108,32 @@ type LinkRule struct {
 
 	// BlockedDomains blocks external links to these domains
 	BlockedDomains []string `yaml:"blocked_domains,omitempty" json:"blocked_domains,omitempty" lc:"block links to these domains"`
+
+	// ForbiddenText lists link texts that do not describe the target, matched
+	// case-insensitively against the whole text (e.g. "click here")
+	ForbiddenText []string `yaml:"forbidden_text,omitempty" json:"forbidden_text,omitempty" lc:"link texts that are not allowed (e.g. click here, here)"`
+
+	// NoURLText flags links whose text is a bare URL
+	NoURLText bool `yaml:"no_url_text,omitempty" json:"no_url_text,omitempty" lc:"flag links whose text is a bare URL"`
+
+	// RequireHTTPS flags http:// links
+	RequireHTTPS bool `yaml:"require_https,omitempty" json:"require_https,omitempty" lc:"flag http:// links"`
+
+	// AllowedSchemes lists URL schemes allowed besides http and https
+	AllowedSchemes []string `yaml:"allowed_schemes,omitempty" json:"allowed_schemes,omitempty" lc:"URL schemes allowed besides http and https (e.g. mailto, tel)"`
+
+	// PreferRelativeFor lists domains (e.g. our own docs site) that should be
+	// linked with relative paths instead of absolute URLs
+	PreferRelativeFor []string `yaml:"prefer_relative_for,omitempty" json:"prefer_relative_for,omitempty" lc:"domains whose links should be relative paths"`
+
+	// Severity level for link violations (error, warning, info). Default: error
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "Link {{.Found}} is not allowed here")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Link {{.Found}} is not allowed here')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations"`
 }
 
 // StructureElement represents an element in the document structure
@@ -61,9 +155,19 @@ type StructureElement struct {
 	// Severity level for violations (error, warning, info). Default: error
 	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
 
+	// Message is a custom template for this element's structure violations
+	// (missing, out of order, occurrence count), e.g. "Every runbook needs {{.Heading}}"
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Every runbook needs {{.Heading}}')"`
+
+	// HelpURL links violations of this element and its section rules to documentation
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations"`
+
 	// AllowAdditional permits extra subsections not defined in children
 	AllowAdditional bool `yaml:"allow_additional,omitempty" json:"allow_additional,omitempty" lc:"allow extra subsections not in schema"`
 
+	// HeadingRules overrides global heading style rules for this section's headings
+	HeadingRules *HeadingStyle `yaml:"heading_rules,omitempty" json:"heading_rules,omitempty" lc:"heading style overrides for this section"`
+
 	// Hierarchical children elements
 	Children []StructureElement `yaml:"children,omitempty" json:"children,omitempty" lc:"nested subsections"`
 
@@ -107,7 +211,10 @@ func (StructureElement) JSONSchema() *jsonschema.Schema {
 	props.Set("optional", &jsonschema.Schema{Type: "boolean", Description: "Section is not required"})
 	props.Set("count", &jsonschema.Schema{Ref: "#/$defs/CountConstraint", Description: "Occurrence constraints {min, max}"})
 	props.Set("severity", &jsonschema.Schema{Type: "string", Enum: []any{"error", "warning", "info"}, Description: "Violation severity: error, warning, or info"})
+	props.Set("message", &jsonschema.Schema{Type: "string", Description: "Custom violation message template for structure violations"})
+	props.Set("help_url", &jsonschema.Schema{Type: "string", Description: "Documentation URL shown with violations"})
 	props.Set("allow_additional", &jsonschema.Schema{Type: "boolean", Description: "Allow extra subsections not in schema"})
+	props.Set("heading_rules", &jsonschema.Schema{Ref: "#/$defs/HeadingStyle", Description: "Heading style overrides for this section"})
 	props.Set("children", &jsonschema.Schema{
 		Type:        "array",
 		Description: "Nested subsections",
@@ -127,6 +234,9 @@ func (StructureElement) JSONSchema() *jsonschema.Schema {
 						p := jsonschema.NewProperties()
 						p.Set("pattern", &jsonschema.Schema{Type: "string", Description: "Text or regex to match"})
 						p.Set("regex", &jsonschema.Schema{Type: "boolean", Description: "Treat as regex"})
+						p.Set("severity", &jsonschema.Schema{Type: "string", Enum: []any{"error", "warning", "info"}, Description: "Violation severity: error, warning, or info"})
+						p.Set("message", &jsonschema.Schema{Type: "string", Description: "Custom violation message template"})
+						p.Set("help_url", &jsonschema.Schema{Type: "string", Description: "Documentation URL shown with violations"})
 						return p
 					}(),
 				},
@@ -146,6 +256,9 @@ func (StructureElement) JSONSchema() *jsonschema.Schema {
 						p := jsonschema.NewProperties()
 						p.Set("pattern", &jsonschema.Schema{Type: "string", Description: "Text or regex that must NOT appear"})
 						p.Set("regex", &jsonschema.Schema{Type: "boolean", Description: "Treat as regex"})
+						p.Set("severity", &jsonschema.Schema{Type: "string", Enum: []any{"error", "warning", "info"}, Description: "Violation severity: error, warning, or info"})
+						p.Set("message", &jsonschema.Schema{Type: "string", Description: "Custom violation message template"})
+						p.Set("help_url", &jsonschema.Schema{Type: "string", Description: "Documentation URL shown with violations"})
 						return p
 					}(),
 				},
@@ -198,8 +311,12 @@ type HeadingPattern struct {
 	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty" lc:"heading regex pattern"`
 
 	// Expr is a boolean expression for dynamic matching (e.g., "slug(filename) == slug(heading)")
-	// Available variables: filename (without extension), heading (heading text)
-	// Available functions: slug, lower, upper, trim, hasPrefix, hasSuffix, strContains, match, replace, trimPrefix, trimSuffix
+	// Available variables: filename (without extension), dirname (containing
+	// directory), path (relative to the schema file), heading (heading text),
+	// level (1-6), parent (parent heading text), index (position among
+	// siblings, from 0), fm (frontmatter map)
+	// Available functions: slug, kebab, title, words, semver, lower, upper, trim,
+	// hasPrefix, hasSuffix, strContains, match, replace, trimPrefix, trimSuffix
 	Expr string `yaml:"expr,omitempty" json:"expr,omitempty" lc:"boolean expression for dynamic matching"`
 }
 
@@ -289,6 +406,15 @@ type RequiredTextPattern struct {
 
 	// Pattern is the regex pattern to match (always treated as regex)
 	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty" lc:"regex pattern to match"`
+
+	// Severity level for violations (error, warning, info). Default: element severity
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "{{.Heading}} must mention {{.Expected}}")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Heading}} must mention {{.Expected}}')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // UnmarshalYAML implements custom unmarshaling to support both string and object syntax
@@ -312,6 +438,19 @@ func (RequiredTextPattern) JSONSchema() *jsonschema.Schema {
 		Type:        "string",
 		Description: "Regex pattern to match",
 	})
+	props.Set("severity", &jsonschema.Schema{
+		Type:        "string",
+		Enum:        []any{"error", "warning", "info"},
+		Description: "Violation severity: error, warning, or info",
+	})
+	props.Set("message", &jsonschema.Schema{
+		Type:        "string",
+		Description: "Custom violation message template",
+	})
+	props.Set("help_url", &jsonschema.Schema{
+		Type:        "string",
+		Description: "Documentation URL shown with violations",
+	})
 
 	return &jsonschema.Schema{
 		OneOf: []*jsonschema.Schema{
@@ -332,6 +471,27 @@ type CodeBlockRule struct {
 	Lang string `yaml:"lang,omitempty" json:"lang,omitempty" lc:"language identifier (bash, go, python, etc.) - omit for any language"`
 	Min  int    `yaml:"min,omitempty" json:"min,omitempty" lc:"minimum required blocks"`
 	Max  int    `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum allowed blocks"`
+
+	// ValidSyntax parses json, yaml, toml and go blocks and reports syntax errors
+	ValidSyntax bool `yaml:"valid_syntax,omitempty" json:"valid_syntax,omitempty" lc:"parse json, yaml, toml, and go blocks and report syntax errors"`
+
+	// Contains and NotContains are checked against the body of each matching block
+	Contains    []RequiredTextPattern  `yaml:"contains,omitempty" json:"contains,omitempty" lc:"text each block must contain (substring or {pattern: regex})"`
+	NotContains []ForbiddenTextPattern `yaml:"not_contains,omitempty" json:"not_contains,omitempty" lc:"text no block may contain (substring or {pattern: regex})"`
+
+	MinLines int `yaml:"min_lines,omitempty" json:"min_lines,omitempty" lc:"minimum lines per block"`
+	MaxLines int `yaml:"max_lines,omitempty" json:"max_lines,omitempty" lc:"maximum lines per block"`
+
+	// RequireLang flags fences without a language; only meaningful when Lang is empty
+	RequireLang bool `yaml:"require_lang,omitempty" json:"require_lang,omitempty" lc:"flag code blocks without a language identifier"`
+
+	// Attributes come from the fence info string, e.g. ```go title="main.go"
+	RequiredAttributes []string `yaml:"required_attributes,omitempty" json:"required_attributes,omitempty" lc:"info string attributes each block must have (e.g. title)"`
+	AllowedAttributes  []string `yaml:"allowed_attributes,omitempty" json:"allowed_attributes,omitempty" lc:"info string attributes blocks may have; others are flagged"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: element severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Heading}} needs {{.Min}} {{.Expected}} examples, found {{.Found}}')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // ForbiddenTextPattern defines a text pattern that must NOT appear
@@ -341,6 +501,15 @@ type ForbiddenTextPattern struct {
 
 	// Pattern is the regex pattern to match (always treated as regex)
 	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty" lc:"regex pattern that must NOT appear"`
+
+	// Severity level for violations (error, warning, info). Default: element severity
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "Remove {{.Expected}} from {{.Heading}}")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Remove {{.Expected}} from {{.Heading}}')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // UnmarshalYAML implements custom unmarshaling to support both string and object syntax
@@ -364,6 +533,19 @@ func (ForbiddenTextPattern) JSONSchema() *jsonschema.Schema {
 		Type:        "string",
 		Description: "Regex pattern that must NOT appear",
 	})
+	props.Set("severity", &jsonschema.Schema{
+		Type:        "string",
+		Enum:        []any{"error", "warning", "info"},
+		Description: "Violation severity: error, warning, or info",
+	})
+	props.Set("message", &jsonschema.Schema{
+		Type:        "string",
+		Description: "Custom violation message template",
+	})
+	props.Set("help_url", &jsonschema.Schema{
+		Type:        "string",
+		Description: "Documentation URL shown with violations",
+	})
 
 	return &jsonschema.Schema{
 		OneOf: []*jsonschema.Schema{
@@ -379,12 +561,87 @@ func (ForbiddenTextPattern) JSONSchema() *jsonschema.Schema {
 	}
 }
 
+// FileSize is a size in bytes, written as a number or with a unit such as
+// "500KB" or "2MB" (units are powers of 1024)
+type FileSize int64
+
+var fileSizeRegex = regexp.MustCompile(`(?i)^\s*(\d+(?:\.\d+)?)\s*(b|kb|kib|mb|mib|gb|gib)?\s*$`)
+
+var fileSizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1 << 10,
+	"kib": 1 << 10,
+	"mb":  1 << 20,
+	"mib": 1 << 20,
+	"gb":  1 << 30,
+	"gib": 1 << 30,
+}
+
+// ParseFileSize parses a size such as "1024", "500KB" or "1.5MB"
+func ParseFileSize(value string) (FileSize, error) {
+	m := fileSizeRegex.FindStringSubmatch(value)
+	if m == nil {
+		return 0, fmt.Errorf("invalid file size %q (use bytes or a unit such as 500KB or 2MB)", value)
+	}
+	n, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid file size %q: %w", value, err)
+	}
+	return FileSize(n * fileSizeUnits[strings.ToLower(m[2])]), nil
+}
+
+// UnmarshalYAML implements custom unmarshaling to support sizes with units
+func (f *FileSize) UnmarshalYAML(node *yaml.Node) error {
+	size, err := ParseFileSize(node.Value)
+	if err != nil {
+		return err
+	}
+	*f = size
+	return nil
+}
+
+// String formats the size with the largest whole unit, e.g. "500KB"
+func (f FileSize) String() string {
+	for _, unit := range []string{"GB", "MB", "KB"} {
+		if size := fileSizeUnits[strings.ToLower(unit)]; int64(f) >= int64(size) && int64(f)%int64(size) == 0 {
+			return fmt.Sprintf("%d%s", int64(f)/int64(size), unit)
+		}
+	}
+	return fmt.Sprintf("%dB", int64(f))
+}
+
+// JSONSchema implements jsonschema.JSONSchemer for union type support (integer | string)
+func (FileSize) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		OneOf: []*jsonschema.Schema{
+			{Type: "integer", Minimum: "0", Description: "Size in bytes"},
+			{Type: "string", Pattern: `^\s*\d+(\.\d+)?\s*([bB]|[kK][iI]?[bB]|[mM][iI]?[bB]|[gG][iI]?[bB])?\s*$`, Description: "Size with a unit, e.g. 500KB or 2MB"},
+		},
+		Description: "File size",
+	}
+}
+
 // ImageRule defines validation for images within a section
 type ImageRule struct {
 	Min        int      `yaml:"min,omitempty" json:"min,omitempty" lc:"minimum required images"`
 	Max        int      `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum allowed images"`
 	RequireAlt bool     `yaml:"require_alt,omitempty" json:"require_alt,omitempty" lc:"require alt text"`
 	Formats    []string `yaml:"formats,omitempty" json:"formats,omitempty" lc:"allowed formats (png, jpg, gif, etc.)"`
+
+	// Local image files are resolved like links: relative to the document, or
+	// to the schema directory for paths starting with "/"
+	ValidateFiles bool     `yaml:"validate_files,omitempty" json:"validate_files,omitempty" lc:"check that local image files exist"`
+	MaxFileSize   FileSize `yaml:"max_file_size,omitempty" json:"max_file_size,omitempty" lc:"maximum local image file size (e.g. 500KB, 2MB)"`
+	MaxWidth      int      `yaml:"max_width,omitempty" json:"max_width,omitempty" lc:"maximum image width in pixels (png, jpeg, gif, svg)"`
+	MaxHeight     int      `yaml:"max_height,omitempty" json:"max_height,omitempty" lc:"maximum image height in pixels (png, jpeg, gif, svg)"`
+
+	MinAltLength int      `yaml:"min_alt_length,omitempty" json:"min_alt_length,omitempty" lc:"minimum alt text length in characters"`
+	AltForbidden []string `yaml:"alt_forbidden,omitempty" json:"alt_forbidden,omitempty" lc:"alt texts that describe nothing (e.g. image, screenshot), case-insensitive"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: element severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Check image {{.Found}} in {{.Heading}}')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // TableRule defines validation for tables within a section
@@ -393,6 +650,37 @@ type TableRule struct {
 	Max             int      `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum allowed tables"`
 	MinColumns      int      `yaml:"min_columns,omitempty" json:"min_columns,omitempty" lc:"minimum columns per table"`
 	RequiredHeaders []string `yaml:"required_headers,omitempty" json:"required_headers,omitempty" lc:"headers that must exist"`
+
+	MinRows        int  `yaml:"min_rows,omitempty" json:"min_rows,omitempty" lc:"minimum body rows per table"`
+	MaxRows        int  `yaml:"max_rows,omitempty" json:"max_rows,omitempty" lc:"maximum body rows per table"`
+	HeadersInOrder bool `yaml:"headers_in_order,omitempty" json:"headers_in_order,omitempty" lc:"required headers must appear in the listed order"`
+	ExactHeaders   bool `yaml:"exact_headers,omitempty" json:"exact_headers,omitempty" lc:"tables may not have headers beyond required_headers"`
+	NoEmptyCells   bool `yaml:"no_empty_cells,omitempty" json:"no_empty_cells,omitempty" lc:"every body cell must have content"`
+
+	// Columns constrains cell values by header name (case-insensitive)
+	Columns map[string]TableColumnRule `yaml:"columns,omitempty" json:"columns,omitempty" lc:"cell constraints by column header"`
+
+	// MatchKeys keeps a column in sync with a code block or frontmatter array
+	MatchKeys *TableKeysRule `yaml:"match_keys,omitempty" json:"match_keys,omitempty" lc:"compare a column with code block keys or a frontmatter array"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: element severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Column {{.Field}} in {{.Heading}} has {{.Found}}')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
+}
+
+// TableKeysRule compares the values of a table column with the keys of the
+// code blocks in the same section, or with the items of a frontmatter array.
+// Exactly one of CodeBlock and Frontmatter should be set.
+type TableKeysRule struct {
+	Column      string `yaml:"column" json:"column" lc:"header of the column listing the keys"`
+	CodeBlock   string `yaml:"code_block,omitempty" json:"code_block,omitempty" lc:"language of the section's code blocks to compare (json, yaml, or toml)"`
+	Frontmatter string `yaml:"frontmatter,omitempty" json:"frontmatter,omitempty" lc:"frontmatter array field to compare (dot-notation)"`
+}
+
+// TableColumnRule constrains the cells of one table column
+type TableColumnRule struct {
+	Enum    []string `yaml:"enum,omitempty" json:"enum,omitempty" lc:"allowed values for non-empty cells"`
+	Pattern string   `yaml:"pattern,omitempty" json:"pattern,omitempty" lc:"regex each non-empty cell must match"`
 }
 
 // ListType represents the type of a list
@@ -413,24 +701,60 @@ func (ListType) JSONSchema() *jsonschema.Schema {
 	}
 }
 
+// TaskState is the required checkbox state of task list items
+type TaskState string
+
+// Task state constants
+const (
+	TaskStateAllChecked  TaskState = "all_checked"
+	TaskStateNoneChecked TaskState = "none_checked"
+)
+
+// JSONSchema implements jsonschema.JSONSchemer to add enum constraint
+func (TaskState) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "string",
+		Enum:        []any{"all_checked", "none_checked"},
+		Description: "Task state: all_checked or none_checked",
+	}
+}
+
 // ListRule defines validation for lists within a section
 type ListRule struct {
 	Min      int      `yaml:"min,omitempty" json:"min,omitempty" lc:"minimum required lists"`
 	Max      int      `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum allowed lists"`
 	Type     ListType `yaml:"type,omitempty" json:"type,omitempty" lc:"ordered, unordered, or empty for any"`
 	MinItems int      `yaml:"min_items,omitempty" json:"min_items,omitempty" lc:"minimum items per list"`
+	MaxItems int      `yaml:"max_items,omitempty" json:"max_items,omitempty" lc:"maximum items per list"`
+
+	// Item counts and patterns apply to top-level lists; tasks apply at any depth
+	ItemPattern string    `yaml:"item_pattern,omitempty" json:"item_pattern,omitempty" lc:"regex each top-level item must match (e.g. '^\\[#\\d+\\]')"`
+	MaxDepth    int       `yaml:"max_depth,omitempty" json:"max_depth,omitempty" lc:"maximum list nesting depth (1 = no nested lists)"`
+	Tasks       TaskState `yaml:"tasks,omitempty" json:"tasks,omitempty" lc:"all_checked or none_checked for task lists"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: element severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Heading}} has {{.Found}} items (max {{.Max}})')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // WordCountRule defines word count constraints for a section
 type WordCountRule struct {
 	Min int `yaml:"min,omitempty" json:"min,omitempty" lc:"minimum words"`
 	Max int `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum words"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: element severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Heading}} has {{.Found}} words (min {{.Min}})')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // ParagraphRule defines paragraph-count requirements for a section
 type ParagraphRule struct {
 	Min int `yaml:"min,omitempty" json:"min,omitempty" lc:"minimum paragraphs"`
 	Max int `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum paragraphs"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: element severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Heading}} has {{.Found}} paragraphs (max {{.Max}})')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: element help_url)"`
 }
 
 // CountConstraint defines how many times a structure element can match
@@ -439,6 +763,78 @@ type CountConstraint struct {
 	Max int `yaml:"max,omitempty" json:"max,omitempty" lc:"maximum occurrences allowed (0 = unlimited)"`
 }
 
+// CodeBlocksConfig defines document-wide settings for fenced code blocks
+type CodeBlocksConfig struct {
+	// Aliases maps a canonical language to its synonyms (e.g. bash: [sh, shell]).
+	// All code block matching compares canonical names.
+	Aliases map[string][]string `yaml:"aliases,omitempty" json:"aliases,omitempty" lc:"canonical language to synonyms, e.g. bash: [sh, shell, console]"`
+
+	// AllowedLanguages flags fences whose language is not in the list
+	AllowedLanguages []string `yaml:"allowed_languages,omitempty" json:"allowed_languages,omitempty" lc:"languages allowed anywhere in the document (aliases accepted)"`
+
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: error)" jsonschema:"enum=error,enum=warning,enum=info"`
+	Message  string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Found}} is not allowed')"`
+	HelpURL  string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations"`
+}
+
+// Canonical returns the canonical name for a language, resolving aliases.
+// Languages without an alias are returned unchanged. Safe on a nil config.
+// Canonical names are tried in sorted order, so a synonym listed under two
+// names (rejected by CheckAliases at load) still resolves the same way.
+func (c *CodeBlocksConfig) Canonical(lang string) string {
+	if c == nil || lang == "" {
+		return lang
+	}
+	for _, canonical := range slices.Sorted(maps.Keys(c.Aliases)) {
+		if strings.EqualFold(lang, canonical) {
+			return canonical
+		}
+		for _, synonym := range c.Aliases[canonical] {
+			if strings.EqualFold(lang, synonym) {
+				return canonical
+			}
+		}
+	}
+	return lang
+}
+
+// CheckAliases returns an error if a language name resolves to more than one
+// canonical name, either listed as a synonym twice or used as both a
+// canonical name and a synonym. Safe on a nil config.
+func (c *CodeBlocksConfig) CheckAliases() error {
+	if c == nil {
+		return nil
+	}
+	owners := make(map[string]string)
+	claim := func(name, canonical string) error {
+		key := strings.ToLower(name)
+		if owner, ok := owners[key]; ok && owner != canonical {
+			return fmt.Errorf("language %q is an alias of both %q and %q", name, owner, canonical)
+		}
+		owners[key] = canonical
+		return nil
+	}
+	canonicals := slices.Sorted(maps.Keys(c.Aliases))
+	for _, canonical := range canonicals {
+		if err := claim(canonical, canonical); err != nil {
+			return err
+		}
+	}
+	for _, canonical := range canonicals {
+		for _, synonym := range c.Aliases[canonical] {
+			if err := claim(synonym, canonical); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// SameLang reports whether two language names are equal after resolving aliases
+func (c *CodeBlocksConfig) SameLang(a, b string) bool {
+	return c.Canonical(a) == c.Canonical(b)
+}
+
 // HeadingRules defines global validation rules for document headings
 type HeadingRules struct {
 	// NoSkipLevels ensures heading levels are not skipped (e.g., h1 -> h3 without h2)
@@ -452,6 +848,110 @@ type HeadingRules struct {
 
 	// MaxDepth limits the maximum heading depth (1-6, where 1 is h1)
 	MaxDepth int `yaml:"max_depth,omitempty" json:"max_depth,omitempty" lc:"maximum heading depth (1-6)"`
+
+	// SingleH1 allows at most one level-1 heading in the document
+	SingleH1 bool `yaml:"single_h1,omitempty" json:"single_h1,omitempty" lc:"allow at most one h1 heading"`
+
+	// Style rules applied to every heading (can be overridden per structure element)
+	HeadingStyle `yaml:",inline"`
+}
+
+// HeadingCase represents the capitalization style required for heading text
+type HeadingCase string
+
+// Heading case constants
+const (
+	HeadingCaseTitle    HeadingCase = "title"    // Every Major Word Capitalized
+	HeadingCaseSentence HeadingCase = "sentence" // Only the first word capitalized
+	HeadingCaseLower    HeadingCase = "lower"    // all words lowercase
+	HeadingCaseAny      HeadingCase = "any"      // no case rule; turns off an inherited one
+)
+
+// JSONSchema implements jsonschema.JSONSchemer to add enum constraint
+func (HeadingCase) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "string",
+		Enum:        []any{"title", "sentence", "lower", "any"},
+		Description: "Heading capitalization: title, sentence, lower, or any (no rule)",
+	}
+}
+
+// HeadingStyle defines style rules for heading text. It is used both globally
+// (inline in HeadingRules) and per structure element, where any setting
+// overrides the global one for headings within that element's section.
+type HeadingStyle struct {
+	// Case requires a capitalization style for heading text ("any" in an
+	// element override turns off a global setting)
+	Case HeadingCase `yaml:"case,omitempty" json:"case,omitempty" lc:"capitalization: title, sentence, lower, or any (no rule)"`
+
+	// Acronyms lists words exempt from case checks (e.g., API, CLI, GitHub)
+	Acronyms []string `yaml:"acronyms,omitempty" json:"acronyms,omitempty" lc:"words exempt from case checks (e.g. API, GitHub)"`
+
+	// NoTrailingPunctuation disallows headings ending with . , ; : or !
+	// (false in an element override turns off a global setting)
+	NoTrailingPunctuation *bool `yaml:"no_trailing_punctuation,omitempty" json:"no_trailing_punctuation,omitempty" lc:"disallow trailing punctuation (. , ; : !)"`
+
+	// MaxLength limits the number of characters in heading text (a negative
+	// value in an element override turns off a global setting)
+	MaxLength int `yaml:"max_length,omitempty" json:"max_length,omitempty" lc:"maximum heading text length in characters (-1: no limit)"`
+
+	// NoEmphasis disallows emphasis (*italic*, **bold**) in heading text
+	NoEmphasis *bool `yaml:"no_emphasis,omitempty" json:"no_emphasis,omitempty" lc:"disallow emphasis in heading text"`
+
+	// NoCode disallows inline code spans in heading text
+	NoCode *bool `yaml:"no_code,omitempty" json:"no_code,omitempty" lc:"disallow inline code in heading text"`
+
+	// Severity level for heading violations (error, warning, info). Default: error
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "Heading '{{.Heading}}' does not follow the style guide")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. 'Heading {{.Heading}} does not follow the style guide')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations"`
+}
+
+// Merge returns a copy of h with every setting in override applied on top.
+// Zero values in override leave the corresponding setting in h unchanged;
+// checks are turned off with case "any", a negative max_length, or false.
+func (h HeadingStyle) Merge(override *HeadingStyle) HeadingStyle {
+	if override == nil {
+		return h
+	}
+	if override.Case != "" {
+		h.Case = override.Case
+	}
+	if len(override.Acronyms) > 0 {
+		h.Acronyms = override.Acronyms
+	}
+	if override.NoTrailingPunctuation != nil {
+		h.NoTrailingPunctuation = override.NoTrailingPunctuation
+	}
+	if override.MaxLength != 0 {
+		h.MaxLength = override.MaxLength
+	}
+	if override.NoEmphasis != nil {
+		h.NoEmphasis = override.NoEmphasis
+	}
+	if override.NoCode != nil {
+		h.NoCode = override.NoCode
+	}
+	if override.Severity != "" {
+		h.Severity = override.Severity
+	}
+	if override.Message != "" {
+		h.Message = override.Message
+	}
+	if override.HelpURL != "" {
+		h.HelpURL = override.HelpURL
+	}
+	return h
+}
+
+// IsZero reports whether no style rule is configured
+func (h HeadingStyle) IsZero() bool {
+	return h.Case == "" && len(h.Acronyms) == 0 && h.NoTrailingPunctuation == nil &&
+		h.MaxLength == 0 && h.NoEmphasis == nil && h.NoCode == nil
 }
 
 // FrontmatterConfig defines validation rules for YAML frontmatter
@@ -459,8 +959,56 @@ type FrontmatterConfig struct {
 	// Optional indicates frontmatter block is not required (default: false = required)
 	Optional bool `yaml:"optional,omitempty" json:"optional,omitempty" lc:"frontmatter block is not required"`
 
+	// Format requires a frontmatter format: yaml (--- delimited), toml (+++
+	// delimited), or json (;;; delimited or a bare object). Default: any.
+	Format string `yaml:"format,omitempty" json:"format,omitempty" lc:"required frontmatter format: yaml, toml, or json (default: any)" jsonschema:"enum=yaml,enum=toml,enum=json"`
+
 	// Fields defines the required/optional fields and their constraints
 	Fields []FrontmatterField `yaml:"fields,omitempty" json:"fields,omitempty" lc:"field definitions"`
+
+	// AdditionalFields set to false rejects top-level keys that are not
+	// declared in Fields and do not match AllowedFields. Default: true.
+	AdditionalFields *bool `yaml:"additional_fields,omitempty" json:"additional_fields,omitempty" lc:"allow keys not declared in fields (default: true)"`
+
+	// AllowedFields lists regex patterns for undeclared keys that are still
+	// accepted when AdditionalFields is false (e.g. "x-.*")
+	AllowedFields []string `yaml:"allowed_fields,omitempty" json:"allowed_fields,omitempty" lc:"regex patterns for extra keys allowed when additional_fields is false (e.g. 'x-.*')"`
+
+	// JSONSchema is a local JSON Schema (draft 2020-12) file the frontmatter
+	// must validate against, relative to the schema file's directory.
+	// unevaluatedProperties, unevaluatedItems, $dynamicRef, $dynamicAnchor,
+	// $recursiveRef, $recursiveAnchor and remote $refs are not supported;
+	// a schema using them is reported as invalid-json-schema.
+	JSONSchema string `yaml:"json_schema,omitempty" json:"json_schema,omitempty" lc:"JSON Schema (draft 2020-12) file for the frontmatter (e.g. ./page.schema.json). Not supported: unevaluatedProperties, unevaluatedItems, $dynamicRef, $dynamicAnchor, $recursiveRef, $recursiveAnchor, remote $refs"`
+
+	// Assertions are cross-field constraints evaluated over the frontmatter
+	Assertions []FrontmatterAssertion `yaml:"assertions,omitempty" json:"assertions,omitempty" lc:"boolean expressions over the frontmatter (e.g. 'updated >= date')"`
+
+	// Severity level for frontmatter violations (error, warning, info). Default: error
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "{{.Field}} must be set")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Field}} must be set')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations"`
+}
+
+// FrontmatterAssertion is a boolean expression over the frontmatter fields,
+// e.g. "draft || len(reviewers ?? []) > 0". Top-level fields are variables
+// (nil when missing) and fm is the whole frontmatter map.
+type FrontmatterAssertion struct {
+	// Expr is the expression that must evaluate to true
+	Expr string `yaml:"expr" json:"expr" lc:"boolean expression over frontmatter fields (e.g. 'updated >= date')"`
+
+	// Severity overrides the frontmatter severity for this assertion
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: frontmatter severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is the violation message template shown when the expression is false
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"violation message template (e.g. 'updated must not precede date')"`
+
+	// HelpURL links violations to documentation explaining the assertion
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: frontmatter help_url)"`
 }
 
 // FieldType represents the type of a frontmatter field
@@ -504,6 +1052,31 @@ func (FieldFormat) JSONSchema() *jsonschema.Schema {
 	}
 }
 
+// ContentSource names document content a frontmatter field must repeat
+type ContentSource string
+
+// Content source constants for frontmatter-body consistency
+const (
+	ContentSourceH1             ContentSource = "h1"              // text of the first H1
+	ContentSourceFirstParagraph ContentSource = "first_paragraph" // text of the first top-level paragraph
+)
+
+// JSONSchema implements jsonschema.JSONSchemer to add enum constraint
+func (ContentSource) JSONSchema() *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "string",
+		Enum:        []any{"h1", "first_paragraph"},
+		Description: "Document content the field must match: h1 or first_paragraph",
+	}
+}
+
+// FrontmatterItems defines constraints for the elements of an array field
+type FrontmatterItems struct {
+	Type    FieldType `yaml:"type,omitempty" json:"type,omitempty" lc:"element type: string, number, boolean, array, date, object"`
+	Enum    []any     `yaml:"enum,omitempty" json:"enum,omitempty" lc:"allowed element values"`
+	Pattern string    `yaml:"pattern,omitempty" json:"pattern,omitempty" lc:"regex string elements must match"`
+}
+
 // FrontmatterField defines a single frontmatter field requirement
 type FrontmatterField struct {
 	// Name is the field name (required). Supports dot-notation for nested
@@ -523,4 +1096,52 @@ type FrontmatterField struct {
 	// Enum restricts the field value to one of the listed values. For array
 	// fields, every element must be one of the listed values.
 	Enum []any `yaml:"enum,omitempty" json:"enum,omitempty" lc:"allowed values (for arrays, applies to each element)"`
+
+	// Pattern is a regex string values must match
+	Pattern string `yaml:"pattern,omitempty" json:"pattern,omitempty" lc:"regex string values must match (e.g. '^v\\d+')"`
+
+	// MinLength and MaxLength bound the length of string values in characters
+	MinLength int `yaml:"min_length,omitempty" json:"min_length,omitempty" lc:"minimum string length"`
+	MaxLength int `yaml:"max_length,omitempty" json:"max_length,omitempty" lc:"maximum string length"`
+
+	// Minimum and Maximum bound number values (inclusive). Pointers, since 0 is
+	// a meaningful bound.
+	Minimum *float64 `yaml:"minimum,omitempty" json:"minimum,omitempty" lc:"minimum number value (inclusive)"`
+	Maximum *float64 `yaml:"maximum,omitempty" json:"maximum,omitempty" lc:"maximum number value (inclusive)"`
+
+	// MinItems, MaxItems and UniqueItems constrain array values
+	MinItems    int  `yaml:"min_items,omitempty" json:"min_items,omitempty" lc:"minimum array length"`
+	MaxItems    int  `yaml:"max_items,omitempty" json:"max_items,omitempty" lc:"maximum array length"`
+	UniqueItems bool `yaml:"unique_items,omitempty" json:"unique_items,omitempty" lc:"array elements must be distinct"`
+
+	// Items constrains each element of an array value
+	Items *FrontmatterItems `yaml:"items,omitempty" json:"items,omitempty" lc:"constraints for each array element"`
+
+	// AdditionalFields set to false rejects keys of an object field that are
+	// not declared as nested fields and do not match AllowedFields
+	AdditionalFields *bool `yaml:"additional_fields,omitempty" json:"additional_fields,omitempty" lc:"allow keys not declared as nested fields (default: true)"`
+
+	// AllowedFields lists regex patterns for undeclared keys of an object
+	// field that are still accepted when AdditionalFields is false
+	AllowedFields []string `yaml:"allowed_fields,omitempty" json:"allowed_fields,omitempty" lc:"regex patterns for extra keys allowed when additional_fields is false"`
+
+	// Matches requires the field to repeat document content, compared as
+	// plain text with whitespace collapsed
+	Matches ContentSource `yaml:"matches,omitempty" json:"matches,omitempty" lc:"document content the value must equal: h1 or first_paragraph"`
+
+	// Deprecated flags documents that still use the field. Deprecated fields
+	// are never required, and are reported as warnings unless Severity is set.
+	Deprecated bool `yaml:"deprecated,omitempty" json:"deprecated,omitempty" lc:"field should no longer be used"`
+
+	// ReplacedBy names the field to use instead of a deprecated one
+	ReplacedBy string `yaml:"replaced_by,omitempty" json:"replaced_by,omitempty" lc:"field to use instead of a deprecated field"`
+
+	// Severity overrides the frontmatter severity for this field's violations
+	Severity string `yaml:"severity,omitempty" json:"severity,omitempty" lc:"violation severity: error, warning, or info (default: frontmatter severity)" jsonschema:"enum=error,enum=warning,enum=info"`
+
+	// Message is a custom violation message template (e.g. "{{.Field}} must be set")
+	Message string `yaml:"message,omitempty" json:"message,omitempty" lc:"custom violation message template (e.g. '{{.Field}} must be set')"`
+
+	// HelpURL links violations to documentation explaining the rule
+	HelpURL string `yaml:"help_url,omitempty" json:"help_url,omitempty" lc:"documentation URL shown with violations (default: frontmatter help_url)"`
 }
@@ -6,6 +6,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/jackchuka/mdschema/internal/expression"
 	"gopkg.in/yaml.v3"
 )
 
@@ -42,10 +43,21 @@ func walkNode(node *yaml.Node, t reflect.Type, warnings *[]Warning) {
 	for t.Kind() == reflect.Pointer {
 		t = t.Elem()
 	}
+	if t == reflect.TypeOf(ForbiddenTextPattern{}) {
+		checkForbiddenPattern(node, warnings)
+	}
 
 	switch node.Kind {
 	case yaml.MappingNode:
-		if t.Kind() != reflect.Struct || opaqueTypes[t] {
+		if t == reflect.TypeOf(map[string]RuleLevel{}) {
+			checkRuleLevels(node, warnings)
+			return
+		}
+		if t.Kind() != reflect.Struct {
+			return
+		}
+		checkMessageTemplate(node, t, warnings)
+		if opaqueTypes[t] {
 			return
 		}
 		if t == reflect.TypeOf(FrontmatterField{}) {
@@ -76,6 +88,131 @@ func walkNode(node *yaml.Node, t reflect.Type, warnings *[]Warning) {
 	}
 }
 
+// checkExpressions compiles every heading expr and frontmatter assertion, so
+// that syntax errors and unknown functions fail the load with the schema line
+// instead of never matching
+func checkExpressions(data []byte) error {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	if doc.Kind == yaml.DocumentNode && len(doc.Content) > 0 {
+		return walkExpressions(doc.Content[0], reflect.TypeOf(Schema{}))
+	}
+	return nil
+}
+
+func walkExpressions(node *yaml.Node, t reflect.Type) error {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		if t.Kind() != reflect.Struct {
+			return nil
+		}
+		var check func(string) error
+		switch t {
+		case reflect.TypeOf(HeadingPattern{}):
+			check = expression.CheckHeading
+		case reflect.TypeOf(FrontmatterAssertion{}):
+			check = expression.CheckAssertion
+		}
+		allowed := allowedKeys(t)
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "expr" && check != nil {
+				if err := check(value.Value); err != nil {
+					return fmt.Errorf("line %d: invalid expr %q: %w", value.Line, value.Value, err)
+				}
+				continue
+			}
+			if ft, ok := allowed[key.Value]; ok {
+				if err := walkExpressions(value, ft); err != nil {
+					return err
+				}
+			}
+		}
+	case yaml.SequenceNode:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return nil
+		}
+		for _, child := range node.Content {
+			if err := walkExpressions(child, t.Elem()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// checkMessageTemplate reports a `message:` value that is not a valid Go
+// template, so a typo surfaces when the schema is loaded rather than silently
+// falling back to the built-in message.
+func checkMessageTemplate(node *yaml.Node, t reflect.Type, warnings *[]Warning) {
+	if _, ok := allowedKeys(t)["message"]; !ok {
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value != "message" {
+			continue
+		}
+		value := node.Content[i+1]
+		if _, err := MessageTemplate(value.Value); err != nil {
+			*warnings = append(*warnings, Warning{
+				Message: fmt.Sprintf("invalid message template: %v", err),
+				Line:    value.Line,
+			})
+		}
+	}
+}
+
+// checkForbiddenPattern reports a forbidden_text entry that matches empty
+// text, such as "" or 'a*'. Empty matches are ignored when validating, so
+// the entry only flags text where it matches at least one character.
+func checkForbiddenPattern(node *yaml.Node, warnings *[]Warning) {
+	value := node
+	if node.Kind == yaml.MappingNode {
+		value = nil
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == "pattern" {
+				value = node.Content[i+1]
+			}
+		}
+	}
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return
+	}
+	if value != node {
+		// Patterns are regexes; invalid ones fall back to substring matching
+		if re, err := regexp.Compile(value.Value); err != nil || !re.MatchString("") {
+			return
+		}
+	} else if value.Value != "" {
+		return
+	}
+	*warnings = append(*warnings, Warning{
+		Message: fmt.Sprintf("forbidden_text %q matches empty text; only non-empty matches are reported", value.Value),
+		Line:    value.Line,
+	})
+}
+
+// checkRuleLevels reports entries of the top-level rules map whose level is
+// not one of off, info, warning, or error. Rule names are checked by the
+// validator, which knows which rules exist.
+func checkRuleLevels(node *yaml.Node, warnings *[]Warning) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		value := node.Content[i+1]
+		if !RuleLevel(value.Value).Valid() {
+			*warnings = append(*warnings, Warning{
+				Message: fmt.Sprintf("invalid level %q for rule %q (ignored; use off, info, warning, or error)", value.Value, node.Content[i].Value),
+				Line:    value.Line,
+			})
+		}
+	}
+}
+
 var dateValueRegex = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 
 // checkEnumTypes reports enum entries that can never match the field's declared
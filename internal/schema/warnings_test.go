@@ -1,6 +1,9 @@
 package schema
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestUnknownKeyInStructureElement(t *testing.T) {
 	data := []byte(`structure:
@@ -194,3 +197,89 @@ heading_rules:
 		t.Fatalf("expected 0 warnings, got %+v", warnings)
 	}
 }
+
+func TestInvalidMessageTemplateWarnings(t *testing.T) {
+	data := []byte(`structure:
+  - heading: "## Rollback"
+    message: "Every runbook needs {{.Heading}}"
+    help_url: https://wiki.example.com/runbooks
+    required_text:
+      - pattern: "revert"
+        message: "Mention {{.Expected"
+    word_count:
+      min: 10
+      message: "{{if .Found}}too short"
+`)
+	warnings, err := checkUnknownKeys(data)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if len(warnings) != 2 {
+		t.Fatalf("expected 2 warnings, got %+v", warnings)
+	}
+	for i, wantLine := range []int{7, 10} {
+		if !strings.HasPrefix(warnings[i].Message, "invalid message template:") {
+			t.Errorf("warnings[%d].Message = %q", i, warnings[i].Message)
+		}
+		if warnings[i].Line != wantLine {
+			t.Errorf("warnings[%d].Line = %d, want %d", i, warnings[i].Line, wantLine)
+		}
+	}
+}
+
+func TestEmptyMatchingForbiddenTextWarnings(t *testing.T) {
+	data := []byte(`structure:
+  - heading: "## Usage"
+    forbidden_text:
+      - "TODO"
+      - ""
+      - pattern: "a*"
+      - pattern: "FIXME-\\d+"
+      - pattern: "^"
+`)
+	warnings, err := checkUnknownKeys(data)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("expected 3 warnings, got %+v", warnings)
+	}
+	for i, wantLine := range []int{5, 6, 8} {
+		if !strings.Contains(warnings[i].Message, "matches empty text") || warnings[i].Line != wantLine {
+			t.Errorf("warnings[%d] = %+v, want line %d", i, warnings[i], wantLine)
+		}
+	}
+}
+
+func TestInvalidRuleLevelWarnings(t *testing.T) {
+	data := []byte(`rules:
+  paragraph: off
+  link: warning
+  heading: loud
+`)
+	warnings, err := checkUnknownKeys(data)
+	if err != nil {
+		t.Fatalf("error: %v", err)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("expected 1 warning, got %+v", warnings)
+	}
+	if !strings.Contains(warnings[0].Message, `invalid level "loud" for rule "heading"`) || warnings[0].Line != 4 {
+		t.Errorf("got %+v", warnings[0])
+	}
+}
+
+func TestMessageTemplateParsesOnce(t *testing.T) {
+	first, err := MessageTemplate("{{.Heading}} needs {{.Min}}")
+	if err != nil {
+		t.Fatalf("MessageTemplate() error: %v", err)
+	}
+	second, _ := MessageTemplate("{{.Heading}} needs {{.Min}}")
+	if first != second {
+		t.Error("MessageTemplate() parsed the same template twice")
+	}
+
+	if _, err := MessageTemplate("{{.Heading"); err == nil {
+		t.Error("MessageTemplate() should fail for an unclosed action")
+	}
+}
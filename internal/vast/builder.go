@@ -7,8 +7,9 @@ import (
 
 // Builder transforms parser output + schema into a validation-ready AST.
 type Builder struct {
-	matcher  *PatternMatcher
-	filename string // Document filename for expression matching
+	matcher *PatternMatcher
+	doc     *parser.Document // Document for expression matching
+	rootDir string           // Directory expression paths are relative to
 }
 
 // NewBuilder creates a new VAST builder.
@@ -27,8 +28,8 @@ func (b *Builder) Build(doc *parser.Document, s *schema.Schema) *Tree {
 		UnmatchedSections: make([]*parser.Section, 0),
 	}
 
-	// Store document path for expression matching
-	b.filename = doc.Path
+	// Store the document for expression matching
+	b.doc = doc
 
 	// Track which sections have been bound at each level
 	boundSections := make(map[*parser.Section]bool)
@@ -95,6 +96,8 @@ func (b *Builder) Build(doc *parser.Document, s *schema.Schema) *Tree {
 	// Collect unmatched sections
 	b.collectUnmatched(doc.Root, boundSections, &tree.UnmatchedSections)
 
+	tree.ExprErrors = b.matcher.ExprErrors()
+
 	return tree
 }
 
@@ -201,7 +204,7 @@ func (b *Builder) findFirstMatchAfter(sections []*parser.Section, element schema
 		if section.StartLine <= minLine {
 			continue
 		}
-		if section.Heading != nil && b.matcher.MatchesHeading(section.Heading, element.Heading, b.filename) {
+		if section.Heading != nil && b.matcher.MatchesHeading(section, element.Heading, b.doc, b.rootDir) {
 			return section
 		}
 	}
@@ -220,7 +223,7 @@ func (b *Builder) findAllMatchesAfter(sections []*parser.Section, element schema
 		if section.StartLine <= minLine {
 			continue
 		}
-		if section.Heading != nil && b.matcher.MatchesHeading(section.Heading, element.Heading, b.filename) {
+		if section.Heading != nil && b.matcher.MatchesHeading(section, element.Heading, b.doc, b.rootDir) {
 			matches = append(matches, section)
 			if maxMatches > 0 && len(matches) >= maxMatches {
 				break
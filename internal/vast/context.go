@@ -28,6 +28,7 @@ type Context struct {
 // Pass "" when no root directory is needed.
 func NewContext(doc *parser.Document, s *schema.Schema, rootDir string) *Context {
 	builder := NewBuilder()
+	builder.rootDir = rootDir
 
 	ctx := &Context{
 		Tree:      builder.Build(doc, s),
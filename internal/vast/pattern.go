@@ -3,9 +3,10 @@ package vast
 import (
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 
-	"github.com/expr-lang/expr"
+	"github.com/jackchuka/mdschema/internal/expression"
 	"github.com/jackchuka/mdschema/internal/parser"
 	"github.com/jackchuka/mdschema/internal/schema"
 )
@@ -14,20 +15,41 @@ import (
 type PatternMatcher struct {
 	// Cache compiled regexes to avoid recompilation
 	regexCache map[string]*regexp.Regexp
+
+	// Expression failures, recorded once per expression and error so an
+	// expression failing on every heading is reported once
+	exprErrors []ExprError
+	exprFailed map[exprKey]bool
+}
+
+type exprKey struct {
+	expr string
+	err  string
 }
 
 // NewPatternMatcher creates a new pattern matcher with caching.
 func NewPatternMatcher() *PatternMatcher {
 	return &PatternMatcher{
 		regexCache: make(map[string]*regexp.Regexp),
+		exprFailed: make(map[exprKey]bool),
 	}
 }
 
-// MatchesHeading checks if a heading matches a HeadingPattern (literal, pattern, or expr).
-func (pm *PatternMatcher) MatchesHeading(heading *parser.Heading, hp schema.HeadingPattern, filename string) bool {
+// ExprErrors returns the heading expressions that failed to evaluate so far,
+// in the order they were first seen.
+func (pm *PatternMatcher) ExprErrors() []ExprError {
+	return slices.Clone(pm.exprErrors)
+}
+
+// MatchesHeading checks if the heading of a section of doc matches a
+// HeadingPattern (literal, pattern, or expr). rootDir is the directory
+// expression paths are relative to ("" for the document path as given).
+func (pm *PatternMatcher) MatchesHeading(section *parser.Section, hp schema.HeadingPattern, doc *parser.Document, rootDir string) bool {
+	heading := section.Heading
+
 	// If expression is provided, use expression matching
 	if hp.Expr != "" {
-		return pm.matchesHeadingExpr(heading, hp.Expr, filename)
+		return pm.matchesHeadingExpr(section, hp.Expr, doc, rootDir)
 	}
 
 	// Construct full heading text with level markers
@@ -45,44 +67,60 @@ func (pm *PatternMatcher) MatchesHeading(heading *parser.Heading, hp schema.Head
 }
 
 // matchesHeadingExpr evaluates an expression to check if heading matches.
-func (pm *PatternMatcher) matchesHeadingExpr(heading *parser.Heading, expression, documentPath string) bool {
+// Expressions that fail to compile or run never match and are recorded in
+// ExprErrors, so a type error is reported rather than read as "no match".
+func (pm *PatternMatcher) matchesHeadingExpr(section *parser.Section, expr string, doc *parser.Document, rootDir string) bool {
 	// Extract filename without extension
-	filename := ExtractFilename(documentPath)
+	filename := ExtractFilename(doc.Path)
 	if filename == "" {
 		return false
 	}
 
-	// Build expression environment
-	env := map[string]any{
-		"filename":    filename,
-		"heading":     heading.Text,
-		"level":       heading.Level,
-		"slug":        parser.GenerateSlug,
-		"kebab":       toKebabCase,
-		"lower":       strings.ToLower,
-		"upper":       strings.ToUpper,
-		"trim":        strings.TrimSpace,
-		"strContains": strings.Contains,
-		"hasPrefix":   strings.HasPrefix,
-		"hasSuffix":   strings.HasSuffix,
-		"replace":     strings.ReplaceAll,
-		"trimPrefix":  trimPrefixRegex,
-		"trimSuffix":  trimSuffixRegex,
-		"match":       matchRegex,
+	h := expression.Heading{
+		Filename: filename,
+		Dirname:  filepath.Base(filepath.Dir(doc.Path)),
+		Path:     relativePath(doc.Path, rootDir),
+		Text:     section.Heading.Text,
+		Level:    section.Heading.Level,
 	}
-
-	program, err := expr.Compile(expression, expr.Env(env), expr.AsBool())
-	if err != nil {
-		return false
+	if h.Dirname == "." {
+		h.Dirname = ""
+	}
+	if parent := section.Parent; parent != nil {
+		if parent.Heading != nil {
+			h.Parent = parent.Heading.Text
+		}
+		h.Index = slices.Index(parent.Children, section)
+	}
+	if doc.FrontMatter != nil {
+		h.FM = doc.FrontMatter.Data
 	}
 
-	result, err := expr.Run(program, env)
+	matched, err := expression.MatchHeading(expr, h)
 	if err != nil {
+		key := exprKey{expr: expr, err: err.Error()}
+		if !pm.exprFailed[key] {
+			pm.exprFailed[key] = true
+			pm.exprErrors = append(pm.exprErrors, ExprError{Expr: expr, Section: section, Err: err})
+		}
 		return false
 	}
+	return matched
+}
 
-	matched, ok := result.(bool)
-	return ok && matched
+// relativePath returns path relative to rootDir with forward slashes, or
+// path itself when it is not below rootDir
+func relativePath(path, rootDir string) string {
+	if rootDir != "" {
+		absRoot, err1 := filepath.Abs(rootDir)
+		absPath, err2 := filepath.Abs(path)
+		if err1 == nil && err2 == nil {
+			if rel, err := filepath.Rel(absRoot, absPath); err == nil && !strings.HasPrefix(rel, "..") {
+				return filepath.ToSlash(rel)
+			}
+		}
+	}
+	return filepath.ToSlash(filepath.Clean(path))
 }
 
 // matchRegexPattern compiles and matches a regex pattern with caching.
@@ -119,52 +157,3 @@ func ExtractFilename(path string) string {
 	ext := filepath.Ext(base)
 	return strings.TrimSuffix(base, ext)
 }
-
-// trimPrefixRegex removes a regex pattern from the start of a string
-func trimPrefixRegex(s, pattern string) string {
-	re, err := regexp.Compile(pattern)
-	if err != nil {
-		return s
-	}
-	loc := re.FindStringIndex(s)
-	if loc != nil && loc[0] == 0 {
-		return s[loc[1]:]
-	}
-	return s
-}
-
-// trimSuffixRegex removes a regex pattern from the end of a string
-func trimSuffixRegex(s, pattern string) string {
-	re, err := regexp.Compile(pattern + "$")
-	if err != nil {
-		return s
-	}
-	return re.ReplaceAllString(s, "")
-}
-
-// matchRegex checks if a string matches a regex pattern
-func matchRegex(s, pattern string) bool {
-	re, err := regexp.Compile(pattern)
-	if err != nil {
-		return false
-	}
-	return re.MatchString(s)
-}
-
-// toKebabCase converts PascalCase/camelCase to kebab-case
-// Examples: "CreateUnit" -> "create-unit", "XMLParser" -> "xml-parser"
-func toKebabCase(s string) string {
-	var result strings.Builder
-	for i, r := range s {
-		if i > 0 && r >= 'A' && r <= 'Z' {
-			// Check if previous char was lowercase or next char is lowercase (for acronyms)
-			prevLower := i > 0 && s[i-1] >= 'a' && s[i-1] <= 'z'
-			nextLower := i+1 < len(s) && s[i+1] >= 'a' && s[i+1] <= 'z'
-			if prevLower || nextLower {
-				result.WriteRune('-')
-			}
-		}
-		result.WriteRune(r)
-	}
-	return strings.ToLower(result.String())
-}
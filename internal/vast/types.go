@@ -119,6 +119,18 @@ type Tree struct {
 
 	// Unmatched sections (sections in document but not in schema)
 	UnmatchedSections []*parser.Section
+
+	// Heading expressions that failed to evaluate, once per expression and error
+	ExprErrors []ExprError
+}
+
+// ExprError is a heading expression that failed to evaluate, e.g. a type
+// error on a frontmatter value or a non-bool result. Section is the first
+// section it failed on.
+type ExprError struct {
+	Expr    string
+	Section *parser.Section
+	Err     error
 }
 
 // Walk traverses all nodes in depth-first order.
@@ -426,3 +426,31 @@ func TestUnboundNodeAccessors(t *testing.T) {
 		t.Errorf("Unbound Location() should fall back to parent, got line %d", line)
 	}
 }
+
+func TestBuilderExpressionVariables(t *testing.T) {
+	content := []byte("---\ntitle: Install\n---\n\n# Install\n\n## Linux\n\n## macOS\n")
+	doc, err := parser.New().Parse("/repo/docs/guides/install.md", content)
+	if err != nil {
+		t.Fatalf("Parse() error: %v", err)
+	}
+
+	s := &schema.Schema{
+		Structure: []schema.StructureElement{
+			{
+				Heading: schema.HeadingPattern{Expr: `heading == fm.title && dirname == "guides" && path == "docs/guides/install.md"`},
+				Children: []schema.StructureElement{
+					{Heading: schema.HeadingPattern{Expr: `parent == "Install" && index == 1`}},
+				},
+			},
+		},
+	}
+
+	ctx := NewContext(doc, s, "/repo")
+	if len(ctx.Tree.Roots) != 1 || !ctx.Tree.Roots[0].IsBound {
+		t.Fatal("top-level expression should bind '# Install'")
+	}
+	child := ctx.Tree.Roots[0].Children[0]
+	if !child.IsBound || child.HeadingText() != "macOS" {
+		t.Errorf("child expression bound %q, want macOS", child.HeadingText())
+	}
+}